@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
@@ -10,12 +11,14 @@ import (
 	"syscall"
 	"time"
 
+	simpleconsumer "github.com/Fuchsoria/banners-rotation/internal/amqp/consumer"
 	simpleproducer "github.com/Fuchsoria/banners-rotation/internal/amqp/producer"
 	"github.com/Fuchsoria/banners-rotation/internal/app"
 	"github.com/Fuchsoria/banners-rotation/internal/bandit"
 	"github.com/Fuchsoria/banners-rotation/internal/config"
 	"github.com/Fuchsoria/banners-rotation/internal/logger"
 	gw "github.com/Fuchsoria/banners-rotation/internal/server/grpc"
+	memorystorage "github.com/Fuchsoria/banners-rotation/internal/storage/memory"
 	sqlstorage "github.com/Fuchsoria/banners-rotation/internal/storage/sql"
 	"github.com/Fuchsoria/banners-rotation/internal/version"
 	_ "github.com/lib/pq"
@@ -26,6 +29,14 @@ var (
 	configFile string
 )
 
+type Storage interface {
+	app.Storage
+	bandit.ModelStorage
+	simpleconsumer.Storage
+	BackfillBannerStats() (int64, error)
+	Close() error
+}
+
 func init() {
 	flag.StringVar(&configFile, "config", "/etc/banners-rotation/config.json", "Path to configuration file")
 }
@@ -48,35 +59,90 @@ func main() {
 
 	ctx, cancel := context.WithCancel(context.Background())
 
-	storage, err := initStorage(ctx, configuration)
+	if flag.Arg(0) == "migrate" {
+		cancel()
+
+		if err := migrate(configuration, flag.Arg(1)); err != nil {
+			logg.Error(err.Error())
+
+			log.Fatal(err)
+		}
+
+		return
+	}
+
+	storage, err := initStorage(ctx, configuration, logg)
 	if err != nil {
 		logg.Error(err.Error())
 
 		log.Fatal(err)
 	}
 
-	conn, err := amqp.Dial(configuration.AMPQ.URI)
-	if err != nil {
-		logg.Error(fmt.Errorf("cannot connect to amqp, %w", err).Error())
+	if flag.Arg(0) == "backfill-stats" {
+		cancel()
+
+		backfillStats(storage, logg)
+
+		return
+	}
+
+	if flag.Arg(0) == "consume" {
+		defer cancel()
+
+		if err := consume(ctx, cancel, configuration, storage, logg); err != nil {
+			logg.Error(err.Error())
+			cancel()
+			log.Fatal(err) //nolint:gocritic
+		}
+
+		return
+	}
+
+	dial := func() (simpleproducer.RMQConnection, error) {
+		return amqp.Dial(configuration.AMPQ.URI)
 	}
 
-	producer := simpleproducer.New(configuration.AMPQ.Name, conn)
+	backoff := simpleproducer.Backoff{Min: configuration.AMPQ.ReconnectMin, Max: configuration.AMPQ.ReconnectMax}
+
+	var confirmTimeout time.Duration
+	if configuration.AMPQ.Confirm {
+		confirmTimeout = configuration.AMPQ.ConfirmTimeout
+	}
+
+	producer := simpleproducer.New(configuration.AMPQ.Name, dial, backoff, confirmTimeout, logg)
 	err = producer.Connect()
 	if err != nil {
-		logg.Error(fmt.Errorf("cannot connect to amqp producer, %w", err).Error())
+		logg.Error(fmt.Errorf("cannot connect to amqp producer, it is reconnecting, %w", err).Error())
 	}
+	defer producer.Close()
+
+	registry, err := initBandit(configuration, storage)
+	if err != nil {
+		logg.Error(err.Error())
 
-	bandit := bandit.New()
+		log.Fatal(err)
+	}
 
-	brApp := app.New(logg, storage, bandit, producer)
+	brApp := app.New(logg, storage, registry, producer, app.Settings{
+		SlotStrategies:         configuration.Bandit.Slots,
+		SocialDemoMinViews:     configuration.Bandit.SocialDemoMinViews,
+		ImpressionKey:          configuration.Impressions.Key,
+		ImpressionTTL:          configuration.Impressions.TTL,
+		RequireImpressionToken: configuration.Impressions.Required,
+		OutboxInterval:         configuration.AMPQ.OutboxInterval,
+		OutboxBatch:            configuration.AMPQ.OutboxBatch,
+		OutboxClaim:            configuration.AMPQ.OutboxClaim,
+	})
 
-	server, err := gw.NewServer(brApp, configuration.HTTP.Host, configuration.HTTP.Port, configuration.HTTP.GrpcPort)
+	server, err := gw.NewServer(brApp, configuration.HTTP.Host, configuration.HTTP.Port, configuration.HTTP.GrpcPort, configuration.HTTP.WriteTimeout)
 	if err != nil {
 		logg.Error(err.Error())
 	}
 
 	defer cancel()
 
+	go brApp.RunOutboxRelay(ctx)
+
 	go func() {
 		signals := make(chan os.Signal, 1)
 		signal.Notify(signals, syscall.SIGINT, syscall.SIGHUP)
@@ -107,7 +173,15 @@ func main() {
 	}
 }
 
-func initStorage(ctx context.Context, configuration config.Config) (*sqlstorage.Storage, error) {
+func initStorage(ctx context.Context, configuration config.Config, logg *logger.Logger) (Storage, error) {
+	switch configuration.Storage.Type {
+	case "memory":
+		return memorystorage.New(), nil
+	case "sql":
+	default:
+		return nil, fmt.Errorf("unknown storage type %q", configuration.Storage.Type)
+	}
+
 	storage, err := sqlstorage.New(ctx, configuration.DB.ConnectionString)
 	if err != nil {
 		return nil, fmt.Errorf("can't create new storage instance, %w", err)
@@ -118,5 +192,133 @@ func initStorage(ctx context.Context, configuration config.Config) (*sqlstorage.
 		return nil, fmt.Errorf("can't connect to storage, %w", err)
 	}
 
+	if configuration.DB.AutoMigrate {
+		applied, err := storage.MigrateUp()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, migration := range applied {
+			logg.Info("migration is applied", "version", migration.Version, "name", migration.Name)
+		}
+	}
+
 	return storage, nil
 }
+
+func initBandit(configuration config.Config, storage bandit.ModelStorage) (*bandit.Registry, error) {
+	seed := time.Now().UnixNano()
+
+	registry := bandit.NewRegistry(configuration.Bandit.Strategy)
+	registry.Register(bandit.UCB1, bandit.New())
+	registry.Register(bandit.EpsilonGreedy, bandit.NewEpsilonGreedy(configuration.Bandit.Epsilon, seed))
+	registry.Register(bandit.Thompson, bandit.NewThompson(configuration.Bandit.PriorAlpha, configuration.Bandit.PriorBeta, seed))
+	registry.Register(bandit.Softmax, bandit.NewSoftmax(configuration.Bandit.Temperature, seed))
+	registry.Register(bandit.Random, bandit.NewRandom(seed))
+	registry.RegisterTimeAware(bandit.SlidingWindow, bandit.NewSlidingWindow(configuration.Bandit.SlidingWindow, configuration.Bandit.SlidingEvents))
+	registry.RegisterTimeAware(bandit.Discounted, bandit.NewDiscounted(configuration.Bandit.HalfLife))
+	registry.RegisterContextual(bandit.LinUCB, bandit.NewLinUCB(configuration.Bandit.LinUCBAlpha, configuration.Bandit.Features, storage, seed))
+
+	if err := registry.Validate(""); err != nil {
+		return nil, fmt.Errorf("invalid default bandit strategy, %w", err)
+	}
+
+	for slotID, strategy := range configuration.Bandit.Slots {
+		if err := registry.Validate(strategy); err != nil {
+			return nil, fmt.Errorf("invalid bandit strategy of slot %q, %w", slotID, err)
+		}
+	}
+
+	return registry, nil
+}
+
+func backfillStats(storage Storage, logg *logger.Logger) {
+	rows, err := storage.BackfillBannerStats()
+	if err != nil {
+		logg.Error(err.Error())
+
+		log.Fatal(err)
+	}
+
+	logg.Info("banner stats are rebuilt", "rows", rows)
+	fmt.Printf("banner stats are rebuilt, %d rows\n", rows)
+}
+
+// consume adds events of queue to hourly rollups until SIGINT or SIGHUP.
+func consume(ctx context.Context, cancel context.CancelFunc, configuration config.Config, storage Storage, logg *logger.Logger) error {
+	conn, err := amqp.Dial(configuration.AMPQ.URI)
+	if err != nil {
+		return fmt.Errorf("cannot connect to amqp, %w", err)
+	}
+	defer conn.Close()
+
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGINT, syscall.SIGHUP)
+
+		select {
+		case <-ctx.Done():
+		case <-signals:
+		}
+
+		signal.Stop(signals)
+		cancel()
+	}()
+
+	logg.Info("banners rotation consumer is running...")
+
+	backoff := simpleproducer.Backoff{Min: configuration.AMPQ.ReconnectMin, Max: configuration.AMPQ.ReconnectMax}
+	consumer := simpleconsumer.New(configuration.AMPQ.Name, configuration.AMPQ.Prefetch, configuration.AMPQ.RetryLimit, backoff,
+		conn, storage, logg)
+
+	return consumer.Consume(ctx)
+}
+
+var ErrUnknownMigrateCommand = errors.New("unknown migrate command, use up, down or status")
+
+func migrate(configuration config.Config, command string) error {
+	storage, err := sqlstorage.New(context.Background(), configuration.DB.ConnectionString)
+	if err != nil {
+		return err
+	}
+	defer storage.Close()
+
+	switch command {
+	case "up":
+		applied, err := storage.MigrateUp()
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range applied {
+			fmt.Printf("applied %04d_%s\n", migration.Version, migration.Name)
+		}
+
+		fmt.Printf("%d migrations are applied\n", len(applied))
+	case "down":
+		reverted, err := storage.MigrateDown()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("reverted %04d_%s\n", reverted.Version, reverted.Name)
+	case "status":
+		statuses, err := storage.MigrationsStatus()
+		if err != nil {
+			return err
+		}
+
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format(time.RFC3339)
+			}
+
+			fmt.Printf("%04d_%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+	default:
+		return fmt.Errorf("%q, %w", command, ErrUnknownMigrateCommand)
+	}
+
+	return nil
+}
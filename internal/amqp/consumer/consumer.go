@@ -0,0 +1,212 @@
+package simpleconsumer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	simpleproducer "github.com/Fuchsoria/banners-rotation/internal/amqp/producer"
+	sqlstorage "github.com/Fuchsoria/banners-rotation/internal/storage/sql"
+	"github.com/streadway/amqp"
+)
+
+var (
+	ErrInvalidMessage   = errors.New("invalid message")
+	ErrDeliveriesClosed = errors.New("deliveries channel is closed")
+)
+
+type RMQConnection interface {
+	Channel() (*amqp.Channel, error)
+}
+
+type Storage interface {
+	AddEventRollup(eventID int64, eventType string, bannerID string, slotID string, socialDemoID string, date time.Time) error
+	PruneProcessedEvents(before time.Time) (int64, error)
+}
+
+const (
+	// processedRetention is how long ids of processed events are kept for deduplication,
+	// events are published again by relay within claim of outbox, so a week is enough.
+	processedRetention = 7 * 24 * time.Hour
+	pruneInterval      = time.Hour
+)
+
+type Logger interface {
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+type Consumer struct {
+	name       string
+	prefetch   int
+	retryLimit int
+	backoff    simpleproducer.Backoff
+	conn       RMQConnection
+	storage    Storage
+	logger     Logger
+	rnd        *rand.Rand
+
+	// attempts are failed attempts of requeued messages by messageKey
+	attempts map[string]int
+}
+
+// New creates consumer, message failed by storage is requeued after backoff delay
+// and it is rejected after retryLimit attempts.
+func New(
+	name string,
+	prefetch int,
+	retryLimit int,
+	backoff simpleproducer.Backoff,
+	conn RMQConnection,
+	storage Storage,
+	logger Logger,
+) *Consumer {
+	return &Consumer{
+		name:       name,
+		prefetch:   prefetch,
+		retryLimit: retryLimit,
+		backoff:    backoff,
+		conn:       conn,
+		storage:    storage,
+		logger:     logger,
+		rnd:        rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
+		attempts:   make(map[string]int),
+	}
+}
+
+// Consume handles messages of queue until ctx is done or deliveries are closed by server.
+func (c *Consumer) Consume(ctx context.Context) error {
+	ch, err := c.conn.Channel()
+	if err != nil {
+		return fmt.Errorf("cannot get channel, %w", err)
+	}
+	defer ch.Close()
+
+	_, err = ch.QueueDeclare(c.name, false,
+		false,
+		false,
+		false,
+		nil)
+	if err != nil {
+		return fmt.Errorf("cannot create queue, %w", err)
+	}
+
+	if err := ch.Qos(c.prefetch, 0, false); err != nil {
+		return fmt.Errorf("cannot set prefetch count, %w", err)
+	}
+
+	deliveries, err := ch.Consume(
+		c.name, // queue
+		"",     // consumer
+		false,  // auto ack
+		false,  // exclusive
+		false,  // no local
+		false,  // no wait
+		nil)
+	if err != nil {
+		return fmt.Errorf("cannot consume queue, %w", err)
+	}
+
+	prune := time.NewTicker(pruneInterval)
+	defer prune.Stop()
+
+	c.prune()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-prune.C:
+			c.prune()
+		case delivery, ok := <-deliveries:
+			if !ok {
+				return ErrDeliveriesClosed
+			}
+
+			if err := c.Handle(ctx, delivery); err != nil {
+				return fmt.Errorf("cannot acknowledge message, %w", err)
+			}
+		}
+	}
+}
+
+// Handle acknowledges delivery after its event is added to rollups, invalid messages are rejected.
+// Message failed by storage is requeued after backoff delay, so failing storage isn't hammered
+// by redeliveries, and it is rejected when it fails retryLimit times.
+func (c *Consumer) Handle(ctx context.Context, delivery amqp.Delivery) error {
+	key := messageKey(delivery)
+	err := c.process(delivery.Body)
+
+	switch {
+	case err == nil:
+		delete(c.attempts, key)
+
+		return delivery.Ack(false)
+	case errors.Is(err, ErrInvalidMessage):
+		c.logger.Warn("message is rejected", "error", err.Error())
+
+		return delivery.Reject(false)
+	}
+
+	attempt := c.attempts[key] + 1
+
+	if attempt >= c.retryLimit {
+		delete(c.attempts, key)
+		c.logger.Error("message is rejected after retries", "message", key, "attempts", attempt, "error", err.Error())
+
+		return delivery.Reject(false)
+	}
+
+	c.attempts[key] = attempt
+
+	c.logger.Error("message is requeued", "message", key, "attempt", attempt, "error", err.Error())
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(c.backoff.Delay(attempt-1, c.rnd)):
+	}
+
+	return delivery.Nack(false, true)
+}
+
+// messageKey identifies delivery between redeliveries, messages without id are identified by hash of body
+// because delivery tag is changed on redelivery.
+func messageKey(delivery amqp.Delivery) string {
+	if delivery.MessageId != "" {
+		return delivery.MessageId
+	}
+
+	hash := sha256.Sum256(delivery.Body)
+
+	return "sha256:" + hex.EncodeToString(hash[:])
+}
+
+func (c *Consumer) prune() {
+	if _, err := c.storage.PruneProcessedEvents(time.Now().Add(-processedRetention)); err != nil {
+		c.logger.Warn("cannot prune processed events", "error", err.Error())
+	}
+}
+
+func (c *Consumer) process(body []byte) error {
+	var message simpleproducer.AMQPMessage
+
+	if err := json.Unmarshal(body, &message); err != nil {
+		return fmt.Errorf("cannot unmarshal message, %s, %w", err, ErrInvalidMessage)
+	}
+
+	if message.SlotID == "" || message.BannerID == "" || message.SocialDemoID == "" || message.Date.IsZero() {
+		return fmt.Errorf("message has empty fields, %w", ErrInvalidMessage)
+	}
+
+	err := c.storage.AddEventRollup(message.ID, message.Type, message.BannerID, message.SlotID, message.SocialDemoID, message.Date)
+	if errors.Is(err, sqlstorage.ErrUnknownEventType) {
+		return fmt.Errorf("%s, %w", err, ErrInvalidMessage)
+	}
+
+	return err
+}
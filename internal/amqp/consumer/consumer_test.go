@@ -0,0 +1,168 @@
+package simpleconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	simpleproducer "github.com/Fuchsoria/banners-rotation/internal/amqp/producer"
+	memorystorage "github.com/Fuchsoria/banners-rotation/internal/storage/memory"
+	sqlstorage "github.com/Fuchsoria/banners-rotation/internal/storage/sql"
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/require"
+)
+
+type testLogger struct{}
+
+func (l testLogger) Warn(msg string, keysAndValues ...interface{})  {}
+func (l testLogger) Error(msg string, keysAndValues ...interface{}) {}
+
+// testAcknowledger keeps the last acknowledgement of delivery.
+type testAcknowledger struct {
+	acked    bool
+	rejected bool
+	requeued bool
+}
+
+func (a *testAcknowledger) Ack(tag uint64, multiple bool) error {
+	a.acked = true
+
+	return nil
+}
+
+func (a *testAcknowledger) Nack(tag uint64, multiple bool, requeue bool) error {
+	a.rejected, a.requeued = true, requeue
+
+	return nil
+}
+
+func (a *testAcknowledger) Reject(tag uint64, requeue bool) error {
+	return a.Nack(tag, false, requeue)
+}
+
+type failingStorage struct{}
+
+func (s failingStorage) AddEventRollup(int64, string, string, string, string, time.Time) error {
+	return errors.New("connection is lost")
+}
+
+func (s failingStorage) PruneProcessedEvents(time.Time) (int64, error) {
+	return 0, nil
+}
+
+var backoff = simpleproducer.Backoff{Min: time.Millisecond, Max: 10 * time.Millisecond}
+
+func newDelivery(t *testing.T, body interface{}) (amqp.Delivery, *testAcknowledger) {
+	t.Helper()
+
+	bytes, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	acknowledger := &testAcknowledger{}
+
+	messageID := ""
+	if message, ok := body.(simpleproducer.AMQPMessage); ok && message.ID > 0 {
+		messageID = strconv.FormatInt(message.ID, 10)
+	}
+
+	return amqp.Delivery{Acknowledger: acknowledger, Body: bytes, MessageId: messageID}, acknowledger
+}
+
+func TestConsumer(t *testing.T) {
+	date := time.Date(2021, 8, 1, 12, 30, 0, 0, time.UTC)
+
+	t.Run("test hourly rollups", func(t *testing.T) {
+		s := memorystorage.New()
+		consumer := New("events", 1, 3, backoff, nil, s, testLogger{})
+
+		messages := []simpleproducer.AMQPMessage{
+			{ID: 1, Type: sqlstorage.EventView, SlotID: "slot1", BannerID: "banner1", SocialDemoID: "social_demo1", Date: date},
+			{ID: 2, Type: sqlstorage.EventView, SlotID: "slot1", BannerID: "banner1", SocialDemoID: "social_demo1", Date: date.Add(20 * time.Minute)},
+			{ID: 3, Type: sqlstorage.EventClick, SlotID: "slot1", BannerID: "banner1", SocialDemoID: "social_demo1", Date: date.Add(25 * time.Minute)},
+			{ID: 2, Type: sqlstorage.EventView, SlotID: "slot1", BannerID: "banner1", SocialDemoID: "social_demo1", Date: date.Add(20 * time.Minute)},
+			{ID: 4, Type: sqlstorage.EventView, SlotID: "slot1", BannerID: "banner1", SocialDemoID: "social_demo1", Date: date.Add(time.Hour)},
+		}
+
+		for _, message := range messages {
+			delivery, acknowledger := newDelivery(t, message)
+
+			require.NoError(t, consumer.Handle(context.Background(), delivery))
+			require.True(t, acknowledger.acked, "repeated message should be acknowledged too")
+		}
+
+		rows, err := s.GetEventRollups(date.Add(-time.Hour), date.Add(2*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, []sqlstorage.RollupRow{
+			{SlotID: "slot1", BannerID: "banner1", SocialDemoID: "social_demo1", Hour: date.Truncate(time.Hour), Views: 2, Clicks: 1},
+			{SlotID: "slot1", BannerID: "banner1", SocialDemoID: "social_demo1", Hour: date.Truncate(time.Hour).Add(time.Hour), Views: 1},
+		}, rows, "repeated message should be counted once")
+	})
+
+	t.Run("test invalid messages are rejected", func(t *testing.T) {
+		consumer := New("events", 1, 3, backoff, nil, memorystorage.New(), testLogger{})
+
+		for _, body := range []interface{}{
+			"message",
+			simpleproducer.AMQPMessage{Type: sqlstorage.EventView, SlotID: "slot1", Date: date},
+			simpleproducer.AMQPMessage{Type: "hover", SlotID: "slot1", BannerID: "banner1", SocialDemoID: "social_demo1", Date: date},
+		} {
+			delivery, acknowledger := newDelivery(t, body)
+
+			require.NoError(t, consumer.Handle(context.Background(), delivery))
+			require.True(t, acknowledger.rejected)
+			require.False(t, acknowledger.requeued)
+		}
+	})
+
+	t.Run("test failed messages are requeued until retry limit", func(t *testing.T) {
+		consumer := New("events", 1, 3, backoff, nil, failingStorage{}, testLogger{})
+		message := simpleproducer.AMQPMessage{
+			ID: 1, Type: sqlstorage.EventClick, SlotID: "slot1", BannerID: "banner1", SocialDemoID: "social_demo1", Date: date,
+		}
+
+		for attempt := 1; attempt < 3; attempt++ {
+			delivery, acknowledger := newDelivery(t, message)
+
+			require.NoError(t, consumer.Handle(context.Background(), delivery))
+			require.False(t, acknowledger.acked)
+			require.True(t, acknowledger.requeued)
+		}
+
+		delivery, acknowledger := newDelivery(t, message)
+
+		require.NoError(t, consumer.Handle(context.Background(), delivery))
+		require.True(t, acknowledger.rejected)
+		require.False(t, acknowledger.requeued, "message should be rejected after retry limit")
+	})
+
+	t.Run("test failed messages without id are rejected after retry limit", func(t *testing.T) {
+		consumer := New("events", 1, 3, backoff, nil, failingStorage{}, testLogger{})
+		message := simpleproducer.AMQPMessage{
+			Type: sqlstorage.EventClick, SlotID: "slot1", BannerID: "banner1", SocialDemoID: "social_demo1", Date: date,
+		}
+		other := message
+		other.Date = date.Add(time.Minute)
+
+		for attempt := 1; attempt < 3; attempt++ {
+			delivery, acknowledger := newDelivery(t, message)
+			require.Empty(t, delivery.MessageId)
+
+			require.NoError(t, consumer.Handle(context.Background(), delivery))
+			require.True(t, acknowledger.requeued)
+		}
+
+		delivery, acknowledger := newDelivery(t, other)
+
+		require.NoError(t, consumer.Handle(context.Background(), delivery))
+		require.True(t, acknowledger.requeued, "attempts of other message should be counted separately")
+
+		delivery, acknowledger = newDelivery(t, message)
+
+		require.NoError(t, consumer.Handle(context.Background(), delivery))
+		require.True(t, acknowledger.rejected)
+		require.False(t, acknowledger.requeued, "message without id should be rejected after retry limit")
+	})
+}
@@ -0,0 +1,144 @@
+package simpleproducer
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/streadway/amqp"
+)
+
+var (
+	ErrNacked         = errors.New("message is nacked by broker")
+	ErrUnroutable     = errors.New("message is returned as unroutable")
+	ErrConfirmTimeout = errors.New("message is not confirmed in time")
+	ErrChannelClosed  = errors.New("channel is closed before confirmation")
+)
+
+// confirmer matches publisher confirms and returns of channel in confirm mode with published messages.
+// Delivery tags are counted from 1 in publish order, so publishing should be done under lock.
+type confirmer struct {
+	mu        sync.Mutex
+	tag       uint64
+	pending   map[uint64]*pendingMessage
+	messageID map[string]uint64
+}
+
+type pendingMessage struct {
+	messageID string
+	returned  bool
+	done      chan error
+}
+
+// newConfirmer puts channel in confirm mode, notifications are unbuffered
+// because broker sends return of message before its ack and library delivers them in order.
+func newConfirmer(ch *amqp.Channel) (*confirmer, error) {
+	if err := ch.Confirm(false); err != nil {
+		return nil, err
+	}
+
+	c := &confirmer{pending: make(map[uint64]*pendingMessage), messageID: make(map[string]uint64)}
+
+	go c.dispatch(ch.NotifyReturn(make(chan amqp.Return)), ch.NotifyPublish(make(chan amqp.Confirmation)))
+
+	return c, nil
+}
+
+// add registers next published message, it should be called right before publishing.
+func (c *confirmer) add(messageID string) (uint64, <-chan error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tag++
+
+	message := &pendingMessage{messageID: messageID, done: make(chan error, 1)}
+	c.pending[c.tag] = message
+	c.messageID[messageID] = c.tag
+
+	return c.tag, message.done
+}
+
+// cancel forgets the last added message when it is not published, so its tag is used again.
+func (c *confirmer) cancel(tag uint64) {
+	c.remove(tag)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if tag == c.tag {
+		c.tag--
+	}
+}
+
+// remove forgets message which is not confirmed in time.
+func (c *confirmer) remove(tag uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if message, ok := c.pending[tag]; ok {
+		delete(c.messageID, message.messageID)
+		delete(c.pending, tag)
+	}
+}
+
+func (c *confirmer) dispatch(returns <-chan amqp.Return, confirms <-chan amqp.Confirmation) {
+	for returns != nil || confirms != nil {
+		select {
+		case returned, ok := <-returns:
+			if !ok {
+				returns = nil
+
+				continue
+			}
+
+			c.returned(returned.MessageId)
+		case confirmation, ok := <-confirms:
+			if !ok {
+				confirms = nil
+
+				continue
+			}
+
+			c.confirmed(confirmation)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for tag, message := range c.pending {
+		message.done <- ErrChannelClosed
+
+		delete(c.pending, tag)
+	}
+}
+
+func (c *confirmer) returned(messageID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if message, ok := c.pending[c.messageID[messageID]]; ok {
+		message.returned = true
+	}
+}
+
+func (c *confirmer) confirmed(confirmation amqp.Confirmation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	message, ok := c.pending[confirmation.DeliveryTag]
+	if !ok {
+		return
+	}
+
+	delete(c.messageID, message.messageID)
+	delete(c.pending, confirmation.DeliveryTag)
+
+	switch {
+	case !confirmation.Ack:
+		message.done <- ErrNacked
+	case message.returned:
+		message.done <- ErrUnroutable
+	default:
+		message.done <- nil
+	}
+}
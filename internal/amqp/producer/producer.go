@@ -4,41 +4,171 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/streadway/amqp"
 )
 
 var errPublish = errors.New("cannot publish message because channel isn't declared")
 
+// AMQPMessage is click or view event, ID is id of outbox row which is used as message id too.
 type AMQPMessage struct {
-	Type         string `json:"type"`
-	SlotID       string `json:"slot_id"`
-	BannerID     string `json:"banner_id"`
-	SocialDemoID string `json:"social_demo_id"`
-	Date         string `json:"date"`
+	ID           int64     `json:"id"`
+	Type         string    `json:"type"`
+	SlotID       string    `json:"slot_id"`
+	BannerID     string    `json:"banner_id"`
+	SocialDemoID string    `json:"social_demo_id"`
+	Date         time.Time `json:"date"`
 }
 
 type RMQConnection interface {
 	Channel() (*amqp.Channel, error)
+	NotifyClose(receiver chan *amqp.Error) chan *amqp.Error
+	Close() error
+}
+
+// Dial opens new connection, it is called again on every reconnection.
+type Dial func() (RMQConnection, error)
+
+type Logger interface {
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+}
+
+type State string
+
+const (
+	StateDisconnected State = "disconnected"
+	StateConnected    State = "connected"
+	StateReconnecting State = "reconnecting"
+	StateClosed       State = "closed"
+)
+
+// Backoff is delay between reconnection attempts, it doubles from Min up to Max.
+type Backoff struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+// Delay returns delay of attempt with jitter, it is random in [d/2, d) of exponential delay d.
+func (b Backoff) Delay(attempt int, rnd *rand.Rand) time.Duration {
+	delay := b.Max
+
+	if attempt < 32 && b.Min<<attempt < b.Max && b.Min<<attempt > 0 {
+		delay = b.Min << attempt
+	}
+
+	if delay < 2 {
+		return delay
+	}
+
+	return delay/2 + time.Duration(rnd.Int63n(int64(delay/2)))
 }
 
 type Producer struct {
-	name    string
-	conn    RMQConnection
-	channel *amqp.Channel
+	name           string
+	dial           Dial
+	backoff        Backoff
+	confirmTimeout time.Duration
+	logger         Logger
+	rnd            *rand.Rand
+
+	mu       sync.RWMutex
+	state    State
+	started  bool
+	conn     RMQConnection
+	channel  *amqp.Channel
+	confirms *confirmer
+
+	// publishMu keeps order of delivery tags in confirm mode
+	publishMu sync.Mutex
+
+	stop chan struct{}
+	done chan struct{}
 }
 
-func New(name string, conn RMQConnection) *Producer {
-	return &Producer{name: name, conn: conn}
+// New creates producer, with positive confirmTimeout channel is put in confirm mode,
+// messages are published as mandatory and Publish waits for their confirmation.
+func New(name string, dial Dial, backoff Backoff, confirmTimeout time.Duration, logger Logger) *Producer {
+	return &Producer{
+		name:           name,
+		dial:           dial,
+		backoff:        backoff,
+		confirmTimeout: confirmTimeout,
+		logger:         logger,
+		rnd:            rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
+		state:          StateDisconnected,
+		stop:           make(chan struct{}),
+		done:           make(chan struct{}),
+	}
 }
 
+// Connect opens channel and declares queue, then connection and channel are watched
+// until Close and reopened when they are closed. Failed first connection is retried in background too.
 func (p *Producer) Connect() error {
-	ch, err := p.conn.Channel()
+	p.mu.Lock()
+	p.started = true
+	p.mu.Unlock()
+
+	closed, err := p.connect()
+
+	go p.watch(closed)
+
+	return err
+}
+
+// Close stops reconnection and closes connection.
+func (p *Producer) Close() error {
+	p.mu.Lock()
+	if p.state == StateClosed {
+		p.mu.Unlock()
+
+		return nil
+	}
+
+	p.state = StateClosed
+	started := p.started
+	p.mu.Unlock()
+
+	close(p.stop)
+
+	if started {
+		<-p.done
+	}
+
+	return p.closeConnection()
+}
+
+// State returns connection state for health checks.
+func (p *Producer) State() State {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.state
+}
+
+// notifications are closes of connection and its channel, closed connection closes channel too.
+type notifications struct {
+	conn    chan *amqp.Error
+	channel chan *amqp.Error
+}
+
+func (p *Producer) connect() (*notifications, error) {
+	conn, err := p.dial()
 	if err != nil {
-		return fmt.Errorf("cannot get channel, %w", err)
+		return nil, fmt.Errorf("cannot connect to amqp, %w", err)
 	}
 
-	p.channel = ch
+	ch, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("cannot get channel, %w", err)
+	}
 
 	_, err = ch.QueueDeclare(p.name, false,
 		false,
@@ -46,28 +176,148 @@ func (p *Producer) Connect() error {
 		false,
 		nil)
 	if err != nil {
-		return fmt.Errorf("cannot create queue, %w", err)
+		conn.Close()
+
+		return nil, fmt.Errorf("cannot create queue, %w", err)
 	}
 
-	return nil
+	var confirms *confirmer
+
+	if p.confirmTimeout > 0 {
+		if confirms, err = newConfirmer(ch); err != nil {
+			conn.Close()
+
+			return nil, fmt.Errorf("cannot put channel in confirm mode, %w", err)
+		}
+	}
+
+	closed := notifications{
+		conn:    conn.NotifyClose(make(chan *amqp.Error, 1)),
+		channel: ch.NotifyClose(make(chan *amqp.Error, 1)),
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.state == StateClosed {
+		conn.Close()
+
+		return nil, nil
+	}
+
+	p.conn, p.channel, p.confirms, p.state = conn, ch, confirms, StateConnected
+
+	return &closed, nil
 }
 
-func (p *Producer) Publish(message AMQPMessage) error {
-	if p.channel != nil {
-		bytes, err := json.Marshal(message)
+func (p *Producer) watch(closed *notifications) {
+	defer close(p.done)
+
+	for {
+		if closed != nil {
+			select {
+			case <-p.stop:
+				return
+			case reason := <-closed.conn:
+				p.logger.Warn("amqp connection is closed", "reason", fmt.Sprint(reason))
+			case reason := <-closed.channel:
+				p.logger.Warn("amqp channel is closed", "reason", fmt.Sprint(reason))
+			}
+		}
+
+		p.mu.Lock()
+		if p.state == StateClosed {
+			p.mu.Unlock()
+
+			return
+		}
+
+		p.channel, p.confirms, p.state = nil, nil, StateReconnecting
+		p.mu.Unlock()
+
+		if err := p.closeConnection(); err != nil {
+			p.logger.Warn("cannot close amqp connection", "error", err.Error())
+		}
+
+		closed = p.reconnect()
+		if closed == nil {
+			return
+		}
+	}
+}
+
+// reconnect retries connection until it is opened, it returns nil when producer is closed.
+func (p *Producer) reconnect() *notifications {
+	for attempt := 0; ; attempt++ {
+		delay := p.backoff.Delay(attempt, p.rnd)
+
+		select {
+		case <-p.stop:
+			return nil
+		case <-time.After(delay):
+		}
+
+		closed, err := p.connect()
 		if err != nil {
-			return fmt.Errorf("cannot marshall message, %w", err)
+			p.logger.Warn("cannot reconnect to amqp", "attempt", attempt+1, "error", err.Error())
+
+			continue
+		}
+
+		if closed != nil {
+			p.logger.Info("amqp connection is restored", "attempt", attempt+1)
 		}
 
-		err = p.channel.Publish(
+		return closed
+	}
+}
+
+func (p *Producer) closeConnection() error {
+	p.mu.Lock()
+	conn := p.conn
+	p.conn, p.channel, p.confirms = nil, nil, nil
+	p.mu.Unlock()
+
+	if conn == nil {
+		return nil
+	}
+
+	if err := conn.Close(); err != nil && !errors.Is(err, amqp.ErrClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// Publish sends message to queue, in confirm mode it returns ErrNacked, ErrUnroutable,
+// ErrConfirmTimeout or ErrChannelClosed when message is not confirmed by broker.
+func (p *Producer) Publish(message AMQPMessage) error {
+	p.mu.RLock()
+	channel, confirms := p.channel, p.confirms
+	p.mu.RUnlock()
+
+	if channel == nil {
+		return errPublish
+	}
+
+	bytes, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("cannot marshall message, %w", err)
+	}
+
+	publishing := amqp.Publishing{
+		ContentType: "text/plain",
+		MessageId:   messageID(message),
+		Body:        bytes,
+	}
+
+	if confirms == nil {
+		err = channel.Publish(
 			"",     // exchange
 			p.name, // routing key
 			false,  // mandatory
 			false,  // immediate
-			amqp.Publishing{
-				ContentType: "text/plain",
-				Body:        bytes,
-			})
+			publishing)
 		if err != nil {
 			return fmt.Errorf("cannot publish message, %w", err)
 		}
@@ -75,5 +325,47 @@ func (p *Producer) Publish(message AMQPMessage) error {
 		return nil
 	}
 
-	return errPublish
+	return p.publishConfirmed(channel, confirms, publishing)
+}
+
+// messageID is id of event, so consumers can deduplicate it, messages without id get random one.
+func messageID(message AMQPMessage) string {
+	if message.ID == 0 {
+		return uuid.NewString()
+	}
+
+	return strconv.FormatInt(message.ID, 10)
+}
+
+func (p *Producer) publishConfirmed(channel *amqp.Channel, confirms *confirmer, publishing amqp.Publishing) error {
+	p.publishMu.Lock()
+
+	tag, done := confirms.add(publishing.MessageId)
+
+	err := channel.Publish("", p.name, true, false, publishing)
+	if err != nil {
+		confirms.cancel(tag)
+	}
+
+	p.publishMu.Unlock()
+
+	if err != nil {
+		return fmt.Errorf("cannot publish message, %w", err)
+	}
+
+	timer := time.NewTimer(p.confirmTimeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("cannot publish message, %w", err)
+		}
+
+		return nil
+	case <-timer.C:
+		confirms.remove(tag)
+
+		return fmt.Errorf("cannot publish message, %w", ErrConfirmTimeout)
+	}
 }
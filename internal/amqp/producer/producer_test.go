@@ -0,0 +1,89 @@
+package simpleproducer
+
+import (
+	"errors"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/require"
+)
+
+type testLogger struct{}
+
+func (l testLogger) Info(msg string, keysAndValues ...interface{}) {}
+func (l testLogger) Warn(msg string, keysAndValues ...interface{}) {}
+
+func TestProducer(t *testing.T) {
+	t.Run("test backoff", func(t *testing.T) {
+		backoff := Backoff{Min: time.Second, Max: 30 * time.Second}
+		rnd := rand.New(rand.NewSource(1))
+
+		for attempt, expected := range []time.Duration{
+			time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second,
+		} {
+			delay := backoff.Delay(attempt, rnd)
+
+			require.GreaterOrEqual(t, int64(delay), int64(expected/2))
+			require.Less(t, int64(delay), int64(expected))
+		}
+
+		require.Less(t, int64(backoff.Delay(100, rnd)), int64(backoff.Max), "delay should not overflow")
+	})
+
+	t.Run("test reconnection", func(t *testing.T) {
+		var dials int32
+
+		dial := func() (RMQConnection, error) {
+			atomic.AddInt32(&dials, 1)
+
+			return nil, errors.New("connection refused")
+		}
+
+		producer := New("events", dial, Backoff{Min: time.Millisecond, Max: 2 * time.Millisecond}, 0, testLogger{})
+		require.Equal(t, StateDisconnected, producer.State())
+
+		require.Error(t, producer.Connect())
+		require.Eventually(t, func() bool { return atomic.LoadInt32(&dials) > 3 }, time.Second, time.Millisecond,
+			"failed connection should be retried")
+		require.Equal(t, StateReconnecting, producer.State())
+		require.Error(t, producer.Publish(AMQPMessage{Type: "view"}))
+
+		require.NoError(t, producer.Close())
+		require.Equal(t, StateClosed, producer.State())
+
+		dialed := atomic.LoadInt32(&dials)
+		time.Sleep(10 * time.Millisecond)
+		require.Equal(t, dialed, atomic.LoadInt32(&dials), "closed producer should not reconnect")
+	})
+
+	t.Run("test confirms", func(t *testing.T) {
+		returns, confirms := make(chan amqp.Return), make(chan amqp.Confirmation)
+		c := &confirmer{pending: make(map[uint64]*pendingMessage), messageID: make(map[string]uint64)}
+
+		go c.dispatch(returns, confirms)
+
+		tag1, acked := c.add("message1")
+		tag2, returned := c.add("message2")
+		tag3, nacked := c.add("message3")
+		tag4, failed := c.add("message4")
+		c.cancel(tag4)
+		tag4, closed := c.add("message4")
+		require.Equal(t, []uint64{1, 2, 3, 4}, []uint64{tag1, tag2, tag3, tag4}, "tag of not published message is reused")
+
+		confirms <- amqp.Confirmation{DeliveryTag: tag1, Ack: true}
+		returns <- amqp.Return{MessageId: "message2"}
+		confirms <- amqp.Confirmation{DeliveryTag: tag2, Ack: true}
+		confirms <- amqp.Confirmation{DeliveryTag: tag3, Ack: false}
+		close(returns)
+		close(confirms)
+
+		require.NoError(t, <-acked)
+		require.ErrorIs(t, <-returned, ErrUnroutable)
+		require.ErrorIs(t, <-nacked, ErrNacked)
+		require.ErrorIs(t, <-closed, ErrChannelClosed)
+		require.Len(t, failed, 0)
+	})
+}
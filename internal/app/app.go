@@ -5,6 +5,8 @@ import (
 	"time"
 
 	simpleproducer "github.com/Fuchsoria/banners-rotation/internal/amqp/producer"
+	"github.com/Fuchsoria/banners-rotation/internal/bandit"
+	"github.com/Fuchsoria/banners-rotation/internal/impression"
 	sqlstorage "github.com/Fuchsoria/banners-rotation/internal/storage/sql"
 	"go.uber.org/zap"
 )
@@ -14,6 +16,34 @@ type App struct {
 	storage  Storage
 	bandit   Bandit
 	producer Producer
+	settings Settings
+	// impressions signs impression tokens, it is nil when tokens are disabled.
+	impressions *impression.Signer
+	// publishing is shared by copies of App given to handlers.
+	publishing *publishCounters
+}
+
+type Settings struct {
+	// SlotStrategies overrides the default bandit strategy for slots created without one.
+	SlotStrategies map[string]string
+	// SocialDemoMinViews is the amount of social demo views in slot below which slot-wide statistics are used,
+	// zero disables the fallback.
+	SocialDemoMinViews int
+	// Clock returns current time of events and statistics periods, time.Now is used when nil.
+	Clock func() time.Time
+	// ImpressionKey is HMAC key of impression tokens, empty key disables tokens.
+	ImpressionKey string
+	// ImpressionTTL is lifetime of impression token, it is 24 hours when zero.
+	ImpressionTTL time.Duration
+	// RequireImpressionToken rejects clicks without impression token.
+	RequireImpressionToken bool
+	// OutboxInterval is pause of outbox relay after it publishes all pending events, it is a second when zero.
+	OutboxInterval time.Duration
+	// OutboxBatch limits events published by relay at once, it is 100 when zero.
+	OutboxBatch int
+	// OutboxClaim is how long events taken by relay are not taken by other relays, it is a minute when zero.
+	// It should be longer than publishing of a batch.
+	OutboxClaim time.Duration
 }
 
 type Logger interface {
@@ -27,27 +57,85 @@ type Logger interface {
 type Storage interface {
 	AddBannerRotation(bannerID string, slotID string) error
 	RemoveBannerRotation(bannerID string, slotID string) error
-	AddClickEvent(bannerID string, slotID string, socialDemoID string, date string) error
-	AddViewEvent(bannerID string, slotID string, socialDemoID string, date string) error
-	GetNotViewedBanners(slotID string) ([]sqlstorage.NotViewedItem, error)
-	GetBannersClicks(slotID string) ([]sqlstorage.ClickItem, error)
-	GetBannersViews(slotID string) ([]sqlstorage.ViewItem, error)
+	AddClickEvent(bannerID string, slotID string, socialDemoID string, date time.Time) error
+	AddImpressionClickEvent(impressionID string, bannerID string, slotID string, socialDemoID string, date time.Time) error
+	AddViewEvent(bannerID string, slotID string, socialDemoID string, date time.Time) error
+	AddImpressionViewEvent(impressionID string, bannerID string, slotID string, socialDemoID string, date time.Time) error
+	HasImpressionView(impressionID string) (bool, error)
+	PublishOutbox(limit int, date time.Time, claim time.Duration, publish func(row sqlstorage.OutboxRow) error) (int, error)
+	GetSlotStats(slotID string) ([]sqlstorage.StatsItem, error)
+	GetSlotsStats(slotIDs []string) ([]sqlstorage.StatsItem, error)
+	GetBannersTimeStats(slotID string, since time.Time) ([]sqlstorage.TimeStatsItem, error)
+	GetBannersTimeStatsBySocialDemo(slotID string, socialDemoID string, since time.Time) ([]sqlstorage.TimeStatsItem, error)
 	GetBannersInSlot(slotID string) ([]sqlstorage.BannerRotationItem, error)
-	CreateBanner(ID string, description string) (string, error)
-	CreateSlot(ID string, description string) (string, error)
+	CreateBanner(ID string, description string, targetURL string) (string, error)
+	CreateSlot(ID string, description string, strategy string) (string, error)
+	GetSlotStrategy(slotID string) (string, error)
+	GetSlotsStrategies(slotIDs []string) ([]sqlstorage.SlotStrategyItem, error)
+	GetBannersInSlots(slotIDs []string) ([]sqlstorage.BannerRotationItem, error)
 	CreateSocialDemo(ID string, description string) (string, error)
+	GetBannerItem(ID string) (sqlstorage.BannerItem, error)
+	ListBanners(afterID string, limit int) ([]sqlstorage.BannerItem, error)
+	UpdateBanner(ID string, description *string, targetURL *string) error
+	DeleteBanner(ID string) error
+	GetSlotItem(ID string) (sqlstorage.SlotItem, error)
+	ListSlots(afterID string, limit int) ([]sqlstorage.SlotItem, error)
+	UpdateSlot(ID string, description *string, strategy *string) error
+	DeleteSlot(ID string) error
+	GetSocialDemoItem(ID string) (sqlstorage.SocialDemoItem, error)
+	ListSocialDemos(afterID string, limit int) ([]sqlstorage.SocialDemoItem, error)
+	UpdateSocialDemo(ID string, description string) error
+	DeleteSocialDemo(ID string) error
+	GetSlotRotation(slotID string) ([]sqlstorage.BannerItem, error)
+	GetBannerSlots(bannerID string) ([]sqlstorage.SlotItem, error)
+	GetStats(query sqlstorage.StatsQuery) ([]sqlstorage.StatsRow, error)
+	StreamStats(query sqlstorage.StatsQuery, fn func(row sqlstorage.StatsRow) error) error
+	StreamEvents(query sqlstorage.StatsQuery, fn func(row sqlstorage.EventRow) error) error
 }
 
 type Producer interface {
 	Publish(message simpleproducer.AMQPMessage) error
+	State() simpleproducer.State
 }
 
 type Bandit interface {
-	Use(items []string, clicks map[string]int, views map[string]int) (string, error)
+	Use(strategy string, items []string, clicks map[string]int, views map[string]int) (string, error)
+	GetContextual(strategy string) (bandit.ContextualStrategy, bool)
+	GetTimeAware(strategy string) (bandit.TimeAwareStrategy, bool)
+	ForgetSlot(slotID string)
+	Validate(strategy string) error
+	Scores(strategy string, items []string, clicks map[string]int, views map[string]int) (map[string]float64, error)
+	Explain(strategy string, items []string, clicks map[string]int, views map[string]int) (map[string]bandit.Explanation, error)
+	Resolve(strategy string) string
 }
 
-func New(logger Logger, storage Storage, bandit Bandit, producer Producer) *App {
-	return &App{logger, storage, bandit, producer}
+func New(logger Logger, storage Storage, bandit Bandit, producer Producer, settings Settings) *App {
+	if settings.Clock == nil {
+		settings.Clock = time.Now
+	}
+
+	if settings.ImpressionTTL == 0 {
+		settings.ImpressionTTL = 24 * time.Hour
+	}
+
+	if settings.OutboxInterval == 0 {
+		settings.OutboxInterval = time.Second
+	}
+
+	if settings.OutboxBatch == 0 {
+		settings.OutboxBatch = 100
+	}
+
+	if settings.OutboxClaim == 0 {
+		settings.OutboxClaim = time.Minute
+	}
+
+	var impressions *impression.Signer
+	if settings.ImpressionKey != "" {
+		impressions = impression.NewSigner([]byte(settings.ImpressionKey), settings.ImpressionTTL)
+	}
+
+	return &App{logger, storage, bandit, producer, settings, impressions, &publishCounters{}}
 }
 
 func (a *App) GetLogger() Logger {
@@ -62,42 +150,68 @@ func (a *App) RemoveBannerRotation(bannerID string, slotID string) error {
 	return a.storage.RemoveBannerRotation(bannerID, slotID)
 }
 
-func (a *App) AddClickEvent(bannerID string, slotID string, socialDemoID string) error {
-	date := time.Now().String()
+func (a *App) AddClickEvent(bannerID string, slotID string, socialDemoID string, features map[string]float64) error {
+	if a.settings.RequireImpressionToken {
+		return ErrImpressionTokenRequired
+	}
+
+	date := a.settings.Clock()
 
 	err := a.storage.AddClickEvent(bannerID, slotID, socialDemoID, date)
 	if err != nil {
 		return fmt.Errorf("cannot create banner click event, %w", err)
 	}
 
-	err = a.producer.Publish(simpleproducer.AMQPMessage{Type: "click", SlotID: slotID, BannerID: bannerID, SocialDemoID: socialDemoID, Date: date})
+	return a.clicked(bannerID, slotID, socialDemoID, features, date)
+}
+
+// clicked passes click to contextual strategy of slot, clicks without features are passed too
+// like views of selections without features, so rewards are not lost.
+func (a *App) clicked(bannerID string, slotID string, socialDemoID string, features map[string]float64, date time.Time) error {
+	strategy, err := a.GetSlotStrategy(slotID)
+	if err != nil {
+		return err
+	}
+
+	if contextual, ok := a.bandit.GetContextual(strategy); ok {
+		if err := contextual.AddClick(slotID, bannerID, features); err != nil {
+			return fmt.Errorf("cannot update contextual bandit with click, %w", err)
+		}
+	}
+
+	return nil
+}
+
+// viewed passes view which is recorded after selection to contextual strategy of slot.
+func (a *App) viewed(bannerID string, slotID string, features map[string]float64) error {
+	strategy, err := a.GetSlotStrategy(slotID)
 	if err != nil {
-		return fmt.Errorf("cannot publish banner click, %w", err)
+		return err
+	}
+
+	if contextual, ok := a.bandit.GetContextual(strategy); ok {
+		if err := contextual.AddView(slotID, bannerID, features); err != nil {
+			return fmt.Errorf("cannot update contextual bandit with view, %w", err)
+		}
 	}
 
 	return nil
 }
 
 func (a *App) AddViewEvent(bannerID string, slotID string, socialDemoID string) error {
-	date := time.Now().String()
+	date := a.settings.Clock()
 
 	err := a.storage.AddViewEvent(bannerID, slotID, socialDemoID, date)
 	if err != nil {
 		return fmt.Errorf("cannot create banner view event, %w", err)
 	}
 
-	err = a.producer.Publish(simpleproducer.AMQPMessage{Type: "view", SlotID: slotID, BannerID: bannerID, SocialDemoID: socialDemoID, Date: date})
-	if err != nil {
-		return fmt.Errorf("cannot publish banner click, %w", err)
-	}
-
 	return nil
 }
 
 func (a *App) MapDataFromDB(
 	bannersInSlot []sqlstorage.BannerRotationItem,
-	bannersClicks []sqlstorage.ClickItem,
-	bannersViews []sqlstorage.ViewItem) (
+	bannersStats []sqlstorage.StatsItem) (
 	banners []string,
 	mappedBannersClicks map[string]int,
 	mappedBannersViews map[string]int,
@@ -109,69 +223,41 @@ func (a *App) MapDataFromDB(
 		banners = append(banners, banner.BannerID)
 	}
 
-	for _, click := range bannersClicks {
-		mappedBannersClicks[click.BannerID]++
-	}
-
-	for _, view := range bannersViews {
-		mappedBannersViews[view.BannerID]++
+	for _, item := range bannersStats {
+		mappedBannersClicks[item.BannerID] += item.Clicks
+		mappedBannersViews[item.BannerID] += item.Views
 	}
 
 	return banners, mappedBannersClicks, mappedBannersViews
 }
 
-func (a *App) GetBanner(slotID string, socialDemoID string) (string, error) {
-	notViewedBanners, err := a.storage.GetNotViewedBanners(slotID)
-	if err != nil {
-		return "", err
+func (a *App) CreateBanner(id string, description string, targetURL string) (string, error) {
+	if err := validateTargetURL(targetURL); err != nil {
+		return "", fmt.Errorf("cannot create banner, %w", err)
 	}
 
-	if len(notViewedBanners) > 0 {
-		bannerID := notViewedBanners[0].BannerID
-
-		err := a.AddViewEvent(bannerID, slotID, socialDemoID)
-		if err != nil {
-			return "", err
-		}
-
-		return bannerID, nil
-	}
-
-	bannersInSlot, err := a.storage.GetBannersInSlot(slotID)
-	if err != nil {
-		return "", err
-	}
-
-	bannersClicks, err := a.storage.GetBannersClicks(slotID)
-	if err != nil {
-		return "", err
-	}
-
-	bannersViews, err := a.storage.GetBannersViews(slotID)
-	if err != nil {
-		return "", err
-	}
+	return a.storage.CreateBanner(id, description, targetURL)
+}
 
-	banners, mappedBannersClicks, mappedBannersViews := a.MapDataFromDB(bannersInSlot, bannersClicks, bannersViews)
-	bannerID, err := a.bandit.Use(banners, mappedBannersClicks, mappedBannersViews)
+func (a *App) GetSlotStrategy(slotID string) (string, error) {
+	strategy, err := a.storage.GetSlotStrategy(slotID)
 	if err != nil {
 		return "", err
 	}
 
-	err = a.AddViewEvent(bannerID, slotID, socialDemoID)
-	if err != nil {
-		return "", err
+	if strategy == "" {
+		strategy = a.settings.SlotStrategies[slotID]
 	}
 
-	return bannerID, nil
+	return strategy, nil
 }
 
-func (a *App) CreateBanner(id string, description string) (string, error) {
-	return a.storage.CreateBanner(id, description)
-}
+func (a *App) CreateSlot(id string, description string, strategy string) (string, error) {
+	if err := a.bandit.Validate(strategy); err != nil {
+		return "", fmt.Errorf("cannot create slot, %w", err)
+	}
 
-func (a *App) CreateSlot(id string, description string) (string, error) {
-	return a.storage.CreateSlot(id, description)
+	return a.storage.CreateSlot(id, description, strategy)
 }
 
 func (a *App) CreateSocialDemo(id string, description string) (string, error) {
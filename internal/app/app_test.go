@@ -0,0 +1,624 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	simpleproducer "github.com/Fuchsoria/banners-rotation/internal/amqp/producer"
+	"github.com/Fuchsoria/banners-rotation/internal/bandit"
+	"github.com/Fuchsoria/banners-rotation/internal/impression"
+	"github.com/Fuchsoria/banners-rotation/internal/storage"
+	memorystorage "github.com/Fuchsoria/banners-rotation/internal/storage/memory"
+	sqlstorage "github.com/Fuchsoria/banners-rotation/internal/storage/sql"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testLogger struct{}
+
+func (l testLogger) Info(msg string, keysAndValues ...interface{})  {}
+func (l testLogger) Warn(msg string, keysAndValues ...interface{})  {}
+func (l testLogger) Debug(msg string, keysAndValues ...interface{}) {}
+func (l testLogger) Error(msg string, keysAndValues ...interface{}) {}
+func (l testLogger) GetInstance() *zap.Logger                       { return zap.NewNop() }
+
+type testProducer struct {
+	messages []simpleproducer.AMQPMessage
+	// err is returned by Publish when it is set.
+	err error
+	// state is returned by State, it is connected when it is empty.
+	state simpleproducer.State
+}
+
+func (p *testProducer) Publish(message simpleproducer.AMQPMessage) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	p.messages = append(p.messages, message)
+
+	return nil
+}
+
+func (p *testProducer) State() simpleproducer.State {
+	if p.state == "" {
+		return simpleproducer.StateConnected
+	}
+
+	return p.state
+}
+
+func newTestApp(t *testing.T, settings Settings) (*App, *memorystorage.Storage, *testProducer) {
+	t.Helper()
+
+	testStorage := memorystorage.New()
+	producer := &testProducer{}
+
+	registry := bandit.NewRegistry(bandit.UCB1)
+	registry.Register(bandit.UCB1, bandit.New())
+	registry.Register(bandit.Random, bandit.NewRandom(1))
+
+	for _, slotID := range []string{"slot1", "slot2"} {
+		_, err := testStorage.CreateSlot(slotID, "", "")
+		require.NoError(t, err)
+	}
+
+	for _, bannerID := range []string{"banner1", "banner2", "banner3"} {
+		_, err := testStorage.CreateBanner(bannerID, "", "")
+		require.NoError(t, err)
+	}
+
+	for _, socialDemoID := range []string{"social_demo1", "social_demo2"} {
+		_, err := testStorage.CreateSocialDemo(socialDemoID, "")
+		require.NoError(t, err)
+	}
+
+	for _, slotID := range []string{"slot1", "slot2"} {
+		for _, bannerID := range []string{"banner1", "banner2", "banner3"} {
+			require.NoError(t, testStorage.AddBannerRotation(bannerID, slotID))
+		}
+	}
+
+	return New(testLogger{}, testStorage, registry, producer, settings), testStorage, producer
+}
+
+func relayOutbox(t *testing.T, app *App) int {
+	t.Helper()
+
+	sent, err := app.RelayOutbox()
+	require.NoError(t, err)
+
+	return sent
+}
+
+// slotEvents returns clicks or views of slot ordered by date.
+func slotEvents(t *testing.T, s *memorystorage.Storage, eventType string, slotID string) []sqlstorage.EventRow {
+	t.Helper()
+
+	events := make([]sqlstorage.EventRow, 0)
+
+	err := s.StreamEvents(sqlstorage.StatsQuery{SlotID: slotID}, func(row sqlstorage.EventRow) error {
+		if row.Type == eventType {
+			events = append(events, row)
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	return events
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestApp(t *testing.T) {
+	t.Run("test every banner is shown first", func(t *testing.T) {
+		app, _, producer := newTestApp(t, Settings{})
+
+		shown := map[string]bool{}
+
+		for i := 0; i < 3; i++ {
+			bannerID, err := app.GetBanner("slot1", "social_demo1", nil)
+			require.NoError(t, err)
+
+			shown[bannerID] = true
+		}
+
+		require.Len(t, shown, 3)
+		require.Equal(t, 3, relayOutbox(t, app))
+		require.Len(t, producer.messages, 3)
+		require.Equal(t, "view", producer.messages[0].Type)
+	})
+
+	t.Run("test clicked banner wins for social demo", func(t *testing.T) {
+		app, _, _ := newTestApp(t, Settings{})
+
+		for i := 0; i < 3; i++ {
+			_, err := app.GetBanner("slot1", "social_demo1", nil)
+			require.NoError(t, err)
+		}
+
+		for i := 0; i < 20; i++ {
+			require.NoError(t, app.AddClickEvent("banner2", "slot1", "social_demo1", nil))
+		}
+
+		results := map[string]int{}
+
+		for i := 0; i < 100; i++ {
+			bannerID, err := app.GetBanner("slot1", "social_demo1", nil)
+			require.NoError(t, err)
+
+			results[bannerID]++
+		}
+
+		require.Greater(t, results["banner2"], results["banner1"])
+		require.Greater(t, results["banner2"], results["banner3"])
+	})
+
+	t.Run("test social demo fallback", func(t *testing.T) {
+		app, testStorage, _ := newTestApp(t, Settings{SocialDemoMinViews: 10})
+
+		for _, bannerID := range []string{"banner1", "banner2", "banner3"} {
+			require.NoError(t, testStorage.AddViewEvent(bannerID, "slot1", "social_demo1", time.Now()))
+		}
+
+		// new social demo uses slot statistics where every banner is already viewed
+		_, err := app.GetBanner("slot1", "social_demo2", nil)
+		require.NoError(t, err)
+
+		stats, err := testStorage.GetSlotStats("slot1")
+		require.NoError(t, err)
+		require.Len(t, stats, 4, "banner should be viewed by both social demos")
+	})
+
+	t.Run("test multiple distinct banners", func(t *testing.T) {
+		app, testStorage, _ := newTestApp(t, Settings{})
+
+		bannerIDs, err := app.GetBanners("slot1", "social_demo1", 5, nil)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"banner1", "banner2", "banner3"}, bannerIDs)
+
+		stats, err := testStorage.GetSlotStats("slot1")
+		require.NoError(t, err)
+		require.Len(t, stats, 3, "view should be added for every banner")
+
+		bannerIDs, err = app.PreviewBanners("slot1", "social_demo1", math.MaxInt32, nil)
+		require.NoError(t, err)
+		require.Len(t, bannerIDs, 3, "huge count should be limited by banners of slot")
+	})
+
+	t.Run("test batch with unique banners", func(t *testing.T) {
+		app, _, _ := newTestApp(t, Settings{})
+
+		requests := []SlotBannerRequest{
+			{SlotID: "slot1", SocialDemoID: "social_demo1"},
+			{SlotID: "slot2", SocialDemoID: "social_demo1"},
+			{SlotID: "slot1", SocialDemoID: "social_demo1"},
+			{SlotID: "slot2", SocialDemoID: "social_demo1"},
+		}
+
+		banners, err := app.GetBannersBatch(requests, true)
+		require.NoError(t, err)
+		require.Len(t, banners, 4)
+
+		seen := map[string]bool{}
+
+		for i, banner := range banners[:3] {
+			require.Equal(t, requests[i].SlotID, banner.SlotID)
+			require.False(t, seen[banner.BannerID], "banner should not be repeated")
+
+			seen[banner.BannerID] = true
+		}
+
+		require.Empty(t, banners[3].BannerID, "all banners are already used")
+	})
+
+	t.Run("test dry run and impression", func(t *testing.T) {
+		app, testStorage, producer := newTestApp(t, Settings{})
+
+		bannerID, err := app.PreviewBanner("slot1", "social_demo1", nil)
+		require.NoError(t, err)
+
+		_, err = app.PreviewBannersBatch([]SlotBannerRequest{{SlotID: "slot1", SocialDemoID: "social_demo1"}}, false)
+		require.NoError(t, err)
+
+		views := slotEvents(t, testStorage, sqlstorage.EventView, "slot1")
+		require.Empty(t, views, "dry run should not record views")
+		require.Empty(t, producer.messages)
+
+		require.NoError(t, app.RecordImpression(bannerID, "slot1", "social_demo1", nil))
+
+		views = slotEvents(t, testStorage, sqlstorage.EventView, "slot1")
+		require.Len(t, views, 1)
+		require.Equal(t, bannerID, views[0].BannerID)
+		require.Equal(t, 1, relayOutbox(t, app))
+		require.Len(t, producer.messages, 1)
+
+		_, err = testStorage.CreateBanner("banner4", "", "")
+		require.NoError(t, err)
+
+		err = app.RecordImpression("banner4", "slot1", "social_demo1", nil)
+		require.ErrorIs(t, err, storage.ErrNotFound, "banner out of rotation should not be recorded")
+	})
+
+	t.Run("test impression tokens", func(t *testing.T) {
+		app, testStorage, producer := newTestApp(t, Settings{ImpressionKey: "secret", RequireImpressionToken: true})
+
+		tokens, err := app.ImpressionTokens(impression.KindServed, "slot1", "social_demo1", []string{"banner1", "banner2"}, nil)
+		require.NoError(t, err)
+		require.Len(t, tokens, 2)
+
+		require.NoError(t, app.AddImpressionClickEvent(tokens[0], "", "", "", nil))
+
+		clicks := slotEvents(t, testStorage, sqlstorage.EventClick, "slot1")
+		require.Len(t, clicks, 1)
+		require.Equal(t, "banner1", clicks[0].BannerID)
+		require.Equal(t, "social_demo1", clicks[0].SocialDemoID)
+		require.Equal(t, 1, relayOutbox(t, app))
+		require.Len(t, producer.messages, 1)
+
+		err = app.AddImpressionClickEvent(tokens[0], "", "", "", nil)
+		require.ErrorIs(t, err, storage.ErrAlreadyExists, "impression should be clicked once")
+
+		err = app.AddImpressionClickEvent(tokens[1], "banner1", "slot1", "social_demo1", nil)
+		require.ErrorIs(t, err, ErrImpressionMismatch)
+
+		err = app.AddImpressionClickEvent(tokens[1]+"x", "", "", "", nil)
+		require.ErrorIs(t, err, impression.ErrInvalidToken)
+
+		err = app.AddClickEvent("banner1", "slot1", "social_demo1", nil)
+		require.ErrorIs(t, err, ErrImpressionTokenRequired)
+	})
+
+	t.Run("test click impression", func(t *testing.T) {
+		now := time.Date(2021, 8, 1, 12, 0, 0, 0, time.UTC)
+		app, testStorage, _ := newTestApp(t, Settings{ImpressionKey: "secret", Clock: func() time.Time { return now }})
+
+		require.NoError(t, app.UpdateBanner("banner1", nil, stringPtr("https://example.com/landing")))
+
+		tokens, err := app.ImpressionTokens(impression.KindServed, "slot1", "social_demo1", []string{"banner1", "banner2"}, nil)
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			targetURL, err := app.ClickImpression(tokens[0])
+			require.NoError(t, err, "repeated click should be redirected too")
+			require.Equal(t, "https://example.com/landing", targetURL)
+		}
+
+		clicks := slotEvents(t, testStorage, sqlstorage.EventClick, "slot1")
+		require.Len(t, clicks, 1, "repeated click should not be recorded")
+
+		_, err = app.ClickImpression(tokens[1])
+		require.ErrorIs(t, err, storage.ErrNotFound, "banner without target url can't be redirected")
+
+		_, err = app.ClickImpression("token")
+		require.ErrorIs(t, err, impression.ErrInvalidToken)
+	})
+
+	t.Run("test view impression", func(t *testing.T) {
+		app, testStorage, _ := newTestApp(t, Settings{ImpressionKey: "secret"})
+
+		tokens, err := app.ImpressionTokens(impression.KindPreview, "slot1", "social_demo1", []string{"banner1"}, nil)
+		require.NoError(t, err)
+
+		err = app.AddImpressionClickEvent(tokens[0], "", "", "", nil)
+		require.ErrorIs(t, err, ErrImpressionNotViewed, "preview should be viewed before click")
+
+		require.NoError(t, app.AddImpressionViewEvent(tokens[0]))
+
+		err = app.AddImpressionViewEvent(tokens[0])
+		require.ErrorIs(t, err, storage.ErrAlreadyExists, "repeated view should not be recorded")
+
+		require.NoError(t, app.AddImpressionClickEvent(tokens[0], "", "", "", nil))
+
+		served, err := app.ImpressionTokens(impression.KindServed, "slot1", "social_demo1", []string{"banner1"}, nil)
+		require.NoError(t, err)
+
+		err = app.AddImpressionViewEvent(served[0])
+		require.ErrorIs(t, err, ErrServedImpression, "view of served impression should not be recorded twice")
+
+		views := slotEvents(t, testStorage, sqlstorage.EventView, "slot1")
+		require.Len(t, views, 1)
+		require.Len(t, slotEvents(t, testStorage, sqlstorage.EventClick, "slot1"), 1)
+
+		err = app.AddImpressionViewEvent("token")
+		require.ErrorIs(t, err, impression.ErrInvalidToken)
+	})
+
+	t.Run("test contextual impression", func(t *testing.T) {
+		app, testStorage, _ := newTestApp(t, Settings{ImpressionKey: "secret"})
+		mobile := map[string]float64{"mobile": 1}
+
+		app.bandit.(*bandit.Registry).RegisterContextual(bandit.LinUCB, bandit.NewLinUCB(1, []string{"mobile"}, testStorage, 1))
+		require.NoError(t, app.UpdateSlot("slot1", nil, stringPtr(bandit.LinUCB)))
+		require.NoError(t, app.UpdateBanner("banner1", nil, stringPtr("https://example.com/landing")))
+
+		model := func() bandit.LinUCBModel {
+			data, err := testStorage.GetBanditModel("slot1", bandit.LinUCB)
+			require.NoError(t, err)
+
+			var model bandit.LinUCBModel
+			require.NoError(t, json.Unmarshal(data, &model))
+
+			return model
+		}
+
+		preview, err := app.ImpressionTokens(impression.KindPreview, "slot1", "social_demo1", []string{"banner1"}, mobile)
+		require.NoError(t, err)
+		require.NoError(t, app.AddImpressionViewEvent(preview[0]))
+
+		_, err = app.ClickImpression(preview[0])
+		require.NoError(t, err)
+
+		arm := model().Arms["banner1"]
+		require.NotNil(t, arm, "view of pixel should reach contextual strategy")
+		require.Equal(t, []float64{1, 1}, arm.B, "click should be added with features of impression")
+	})
+
+	t.Run("test impression tokens are disabled", func(t *testing.T) {
+		app, _, _ := newTestApp(t, Settings{})
+
+		tokens, err := app.ImpressionTokens(impression.KindServed, "slot1", "social_demo1", []string{"banner1"}, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{""}, tokens)
+
+		err = app.AddImpressionClickEvent("token", "", "", "", nil)
+		require.ErrorIs(t, err, ErrImpressionTokensDisabled)
+	})
+
+	t.Run("test unknown slot", func(t *testing.T) {
+		app, _, _ := newTestApp(t, Settings{})
+
+		_, err := app.GetBanner("slot3", "social_demo1", nil)
+		require.ErrorIs(t, err, bandit.ErrEmptySlice)
+	})
+
+	t.Run("test unknown social demo", func(t *testing.T) {
+		app, _, _ := newTestApp(t, Settings{})
+
+		_, err := app.GetBanner("slot1", "social_demo3", nil)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+
+		err = app.AddClickEvent("banner1", "slot1", "social_demo3", nil)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("test event time", func(t *testing.T) {
+		now := time.Date(2021, 8, 1, 12, 30, 0, 0, time.UTC)
+		app, testStorage, producer := newTestApp(t, Settings{Clock: func() time.Time { return now }})
+
+		require.NoError(t, app.AddClickEvent("banner1", "slot1", "social_demo1", nil))
+		require.NoError(t, app.AddViewEvent("banner1", "slot1", "social_demo1"))
+
+		clicks := slotEvents(t, testStorage, sqlstorage.EventClick, "slot1")
+		require.Equal(t, now, clicks[0].Date)
+
+		views := slotEvents(t, testStorage, sqlstorage.EventView, "slot1")
+		require.Equal(t, now, views[0].Date)
+
+		require.Equal(t, 2, relayOutbox(t, app))
+		require.Len(t, producer.messages, 2)
+		require.Equal(t, now, producer.messages[0].Date)
+	})
+
+	t.Run("test outbox relay", func(t *testing.T) {
+		app, _, producer := newTestApp(t, Settings{OutboxBatch: 2})
+
+		require.NoError(t, app.AddViewEvent("banner1", "slot1", "social_demo1"))
+
+		producer.err = fmt.Errorf("cannot publish message, %w", simpleproducer.ErrUnroutable)
+		require.NoError(t, app.AddClickEvent("banner1", "slot1", "social_demo1", nil), "event is stored when amqp fails")
+		require.NoError(t, app.AddViewEvent("banner2", "slot1", "social_demo1"))
+
+		sent, err := app.RelayOutbox()
+		require.ErrorIs(t, err, simpleproducer.ErrUnroutable)
+		require.Equal(t, 0, sent)
+
+		producer.err = fmt.Errorf("cannot publish message, %w", simpleproducer.ErrConfirmTimeout)
+		_, err = app.RelayOutbox()
+		require.ErrorIs(t, err, simpleproducer.ErrConfirmTimeout)
+
+		producer.err = nil
+		require.Equal(t, 2, relayOutbox(t, app), "relay should publish batch")
+		require.Equal(t, 1, relayOutbox(t, app))
+		require.Equal(t, 0, relayOutbox(t, app), "sent events should not be published again")
+
+		types := make([]string, 0, len(producer.messages))
+		ids := make([]int64, 0, len(producer.messages))
+		for _, message := range producer.messages {
+			types = append(types, message.Type)
+			ids = append(ids, message.ID)
+		}
+
+		require.Equal(t, []string{"view", "click", "view"}, types, "events should be published in order")
+		require.Equal(t, []int64{1, 2, 3}, ids, "messages should have ids of outbox rows")
+
+		health := app.Health()
+		require.Equal(t, HealthOK, health.Status)
+		require.Equal(t, PublishStats{Published: 3, Unroutable: 1, TimedOut: 1}, health.Events)
+
+		producer.state = simpleproducer.StateReconnecting
+		health = app.Health()
+		require.Equal(t, HealthDegraded, health.Status)
+		require.Equal(t, simpleproducer.StateReconnecting, health.AMQP)
+
+		require.NoError(t, app.AddViewEvent("banner3", "slot1", "social_demo1"))
+		producer.err = fmt.Errorf("cannot publish message, %w", simpleproducer.ErrChannelClosed)
+		require.Equal(t, 0, relayOutbox(t, app), "relay should wait for connection")
+		require.Equal(t, PublishStats{Published: 3, Unroutable: 1, TimedOut: 1}, app.PublishStats(),
+			"attempts should not be made while producer is reconnecting")
+
+		producer.state, producer.err = "", nil
+		require.Equal(t, 1, relayOutbox(t, app))
+	})
+
+	t.Run("test list pages", func(t *testing.T) {
+		app, _, _ := newTestApp(t, Settings{})
+
+		banners, pageToken, err := app.ListBanners(2, "")
+		require.NoError(t, err)
+		require.Equal(t, []string{"banner1", "banner2"}, []string{banners[0].ID, banners[1].ID})
+		require.NotEmpty(t, pageToken)
+
+		banners, pageToken, err = app.ListBanners(2, pageToken)
+		require.NoError(t, err)
+		require.Len(t, banners, 1)
+		require.Equal(t, "banner3", banners[0].ID)
+		require.Empty(t, pageToken, "last page should not have next page token")
+
+		_, _, err = app.ListSlots(0, "not base64!")
+		require.ErrorIs(t, err, ErrInvalidPageToken)
+
+		slots, pageToken, err := app.ListSlots(0, "")
+		require.NoError(t, err)
+		require.Len(t, slots, 2)
+		require.Empty(t, pageToken)
+	})
+
+	t.Run("test update and delete", func(t *testing.T) {
+		app, _, _ := newTestApp(t, Settings{})
+
+		require.NoError(t, app.UpdateBanner("banner1", stringPtr("new description"), stringPtr("https://example.com/landing")))
+		require.NoError(t, app.UpdateBanner("banner1", stringPtr("newer description"), nil))
+		require.ErrorIs(t, app.UpdateBanner("banner4", stringPtr(""), nil), storage.ErrNotFound)
+		require.ErrorIs(t, app.UpdateBanner("banner1", nil, stringPtr("javascript:alert(1)")), ErrInvalidTargetURL)
+		require.ErrorIs(t, app.UpdateSlot("slot1", nil, stringPtr("unknown")), bandit.ErrUnknownStrategy)
+		require.NoError(t, app.UpdateSlot("slot1", nil, stringPtr(bandit.Random)))
+		require.NoError(t, app.UpdateSlot("slot1", stringPtr("new description"), nil))
+
+		banner, err := app.ReadBanner("banner1")
+		require.NoError(t, err)
+		require.Equal(t, "newer description", banner.Description)
+		require.Equal(t, "https://example.com/landing", banner.TargetURL, "omitted target url should be kept")
+
+		slot, err := app.ReadSlot("slot1")
+		require.NoError(t, err)
+		require.Equal(t, "new description", slot.Description)
+		require.Equal(t, bandit.Random, slot.Strategy, "omitted strategy should be kept")
+
+		_, err = app.GetBanner("slot1", "social_demo1", nil)
+		require.NoError(t, err)
+
+		require.NoError(t, app.DeleteBanner("banner1"))
+		require.NoError(t, app.DeleteBanner("banner2"))
+		require.NoError(t, app.DeleteBanner("banner3"))
+		require.ErrorIs(t, app.DeleteBanner("banner3"), storage.ErrNotFound)
+
+		_, err = app.ReadBanner("banner1")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+
+		_, err = app.GetBanner("slot1", "social_demo1", nil)
+		require.ErrorIs(t, err, bandit.ErrEmptySlice, "rotations should be removed with banners")
+	})
+
+	t.Run("test slot rotation", func(t *testing.T) {
+		app, testStorage, _ := newTestApp(t, Settings{SlotStrategies: map[string]string{"slot2": bandit.Random}})
+
+		require.NoError(t, testStorage.AddViewEvent("banner1", "slot1", "social_demo1", time.Now()))
+		require.NoError(t, testStorage.AddViewEvent("banner1", "slot1", "social_demo2", time.Now()))
+		require.NoError(t, testStorage.AddClickEvent("banner1", "slot1", "social_demo2", time.Now()))
+
+		strategy, banners, err := app.GetSlotRotation("slot1")
+		require.NoError(t, err)
+		require.Equal(t, "", strategy, "default strategy is used")
+		require.Len(t, banners, 3)
+		require.Equal(t, "banner1", banners[0].BannerID)
+		require.Equal(t, 2, banners[0].Views)
+		require.Equal(t, 1, banners[0].Clicks)
+		require.Equal(t, 0.5, banners[0].CTR)
+		require.Equal(t, bandit.New().GetScore(2, 1, 1), banners[0].Score)
+		require.True(t, math.IsInf(banners[1].Score, 1), "not viewed banner should have infinite score")
+
+		_, _, err = app.GetSlotRotation("slot3")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+
+		slots, err := app.GetBannerSlots("banner1")
+		require.NoError(t, err)
+		require.Len(t, slots, 2)
+		require.Equal(t, "slot1", slots[0].SlotID)
+		require.Equal(t, banners[0].Score, slots[0].Score)
+		require.Equal(t, bandit.Random, slots[1].Strategy)
+		require.Equal(t, 1.0/3, slots[1].Score)
+
+		_, err = app.GetBannerSlots("banner4")
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("test stats", func(t *testing.T) {
+		app, testStorage, _ := newTestApp(t, Settings{})
+
+		require.NoError(t, testStorage.AddViewEvent("banner1", "slot1", "social_demo1", time.Now()))
+		require.NoError(t, testStorage.AddViewEvent("banner1", "slot2", "social_demo1", time.Now()))
+		require.NoError(t, testStorage.AddClickEvent("banner1", "slot2", "social_demo1", time.Now()))
+
+		rows, err := app.GetStats(sqlstorage.StatsQuery{GroupBy: []string{sqlstorage.StatsBySlot, sqlstorage.StatsBySocialDemo}})
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		require.Equal(t, StatsRow{SlotID: "slot2", SocialDemoID: "social_demo1", Views: 1, Clicks: 1, CTR: 1}, rows[1])
+
+		_, err = app.GetStats(sqlstorage.StatsQuery{Bucket: "month"})
+		require.ErrorIs(t, err, sqlstorage.ErrInvalidStatsQuery)
+	})
+
+	t.Run("test explain selection", func(t *testing.T) {
+		app, testStorage, producer := newTestApp(t, Settings{SlotStrategies: map[string]string{"slot2": bandit.Random}})
+
+		require.NoError(t, testStorage.AddViewEvent("banner1", "slot1", "social_demo1", time.Now()))
+		require.NoError(t, testStorage.AddViewEvent("banner1", "slot1", "social_demo1", time.Now()))
+		require.NoError(t, testStorage.AddClickEvent("banner1", "slot1", "social_demo1", time.Now()))
+		require.NoError(t, testStorage.AddViewEvent("banner2", "slot1", "social_demo1", time.Now()))
+
+		explanation, err := app.ExplainSelection("slot1", "social_demo1", nil)
+		require.NoError(t, err)
+		require.Equal(t, bandit.UCB1, explanation.Strategy, "default strategy should be resolved")
+		require.Len(t, explanation.Candidates, 3)
+		require.Equal(t, "banner3", explanation.Candidates[0].BannerID, "not viewed banner should be first")
+
+		candidate := explanation.Candidates[1]
+		require.Equal(t, "banner1", candidate.BannerID)
+		require.Equal(t, 2.0, candidate.Views)
+		require.Equal(t, 0.5, candidate.Exploitation)
+		require.Equal(t, bandit.New().GetScore(2, 1, 2), candidate.Score)
+		require.Equal(t, candidate.Score, candidate.Exploitation+candidate.Exploration)
+
+		views := slotEvents(t, testStorage, sqlstorage.EventView, "slot1")
+		require.Len(t, views, 3, "explanation should not record views")
+		require.Empty(t, producer.messages)
+
+		explanation, err = app.ExplainSelection("slot2", "social_demo1", nil)
+		require.NoError(t, err)
+		require.Equal(t, bandit.Random, explanation.Strategy)
+		require.Equal(t, 1.0/3, explanation.Candidates[0].Score)
+		require.Zero(t, explanation.Candidates[0].Exploration)
+
+		_, err = app.PreviewBanner("slot1", "social_demo3", nil)
+		require.NoError(t, err)
+
+		explanation, err = app.ExplainSelection("slot1", "social_demo3", nil)
+		require.NoError(t, err, "explanation should accept social demo which selection accepts")
+		require.Len(t, explanation.Candidates, 3)
+	})
+
+	t.Run("test slot strategy", func(t *testing.T) {
+		app, _, _ := newTestApp(t, Settings{SlotStrategies: map[string]string{"slot2": bandit.Random}})
+
+		_, err := app.CreateSlot("slot3", "", "unknown")
+		require.ErrorIs(t, err, bandit.ErrUnknownStrategy)
+
+		_, err = app.CreateSlot("slot3", "", bandit.Random)
+		require.NoError(t, err)
+
+		strategy, err := app.GetSlotStrategy("slot3")
+		require.NoError(t, err)
+		require.Equal(t, bandit.Random, strategy)
+
+		strategy, err = app.GetSlotStrategy("slot2")
+		require.NoError(t, err)
+		require.Equal(t, bandit.Random, strategy, "strategy from settings")
+	})
+}
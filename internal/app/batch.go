@@ -0,0 +1,150 @@
+package app
+
+import (
+	"errors"
+
+	"github.com/Fuchsoria/banners-rotation/internal/bandit"
+	sqlstorage "github.com/Fuchsoria/banners-rotation/internal/storage/sql"
+)
+
+type SlotBannerRequest struct {
+	SlotID       string
+	SocialDemoID string
+	Features     map[string]float64
+}
+
+type SlotBanner struct {
+	SlotID   string
+	BannerID string
+}
+
+// batchData is statistics of all slots of batch loaded with shared queries.
+type batchData struct {
+	strategies map[string]string
+	banners    map[string][]string
+	stats      map[string][]sqlstorage.StatsItem
+}
+
+// GetBannersBatch returns banner for every requested slot in requests order, slot gets empty banner id
+// when it has no banners left. With unique flag banner is never repeated across slots of batch.
+func (a *App) GetBannersBatch(requests []SlotBannerRequest, unique bool) ([]SlotBanner, error) {
+	return a.getBannersBatch(requests, unique, true)
+}
+
+// PreviewBannersBatch selects banners like GetBannersBatch but does not record views.
+func (a *App) PreviewBannersBatch(requests []SlotBannerRequest, unique bool) ([]SlotBanner, error) {
+	return a.getBannersBatch(requests, unique, false)
+}
+
+func (a *App) getBannersBatch(requests []SlotBannerRequest, unique bool, record bool) ([]SlotBanner, error) {
+	data, err := a.loadBatchData(requests)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]bool)
+	result := make([]SlotBanner, 0, len(requests))
+
+	for _, request := range requests {
+		candidates := make([]string, 0, len(data.banners[request.SlotID]))
+
+		for _, banner := range data.banners[request.SlotID] {
+			if !unique || !used[banner] {
+				candidates = append(candidates, banner)
+			}
+		}
+
+		bannerIDs, err := a.selectBatchBanner(data, request, candidates)
+		if errors.Is(err, bandit.ErrEmptySlice) {
+			result = append(result, SlotBanner{SlotID: request.SlotID})
+
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		used[bannerIDs[0]] = true
+		result = append(result, SlotBanner{SlotID: request.SlotID, BannerID: bannerIDs[0]})
+	}
+
+	if !record {
+		return result, nil
+	}
+
+	for i, request := range requests {
+		if result[i].BannerID == "" {
+			continue
+		}
+
+		err := a.addBannerView(data.strategies[request.SlotID], result[i].BannerID, request.SlotID, request.SocialDemoID, request.Features)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (a *App) loadBatchData(requests []SlotBannerRequest) (*batchData, error) {
+	slotIDs := make([]string, 0, len(requests))
+	data := &batchData{
+		strategies: make(map[string]string),
+		banners:    make(map[string][]string),
+		stats:      make(map[string][]sqlstorage.StatsItem),
+	}
+
+	for _, request := range requests {
+		if _, ok := data.strategies[request.SlotID]; !ok {
+			data.strategies[request.SlotID] = a.settings.SlotStrategies[request.SlotID]
+			slotIDs = append(slotIDs, request.SlotID)
+		}
+	}
+
+	strategies, err := a.storage.GetSlotsStrategies(slotIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range strategies {
+		if item.Strategy != "" {
+			data.strategies[item.SlotID] = item.Strategy
+		}
+	}
+
+	bannersInSlots, err := a.storage.GetBannersInSlots(slotIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range bannersInSlots {
+		data.banners[item.SlotID] = append(data.banners[item.SlotID], item.BannerID)
+	}
+
+	stats, err := a.storage.GetSlotsStats(slotIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range stats {
+		data.stats[item.SlotID] = append(data.stats[item.SlotID], item)
+	}
+
+	return data, nil
+}
+
+// selectBatchBanner uses loaded statistics for counters based strategies,
+// contextual and time aware strategies load their own data.
+func (a *App) selectBatchBanner(data *batchData, request SlotBannerRequest, candidates []string) ([]string, error) {
+	strategy := data.strategies[request.SlotID]
+
+	_, isContextual := a.bandit.GetContextual(strategy)
+	_, isTimeAware := a.bandit.GetTimeAware(strategy)
+
+	if isContextual || isTimeAware {
+		return a.selectBanners(strategy, request.SlotID, request.SocialDemoID, candidates, 1, request.Features)
+	}
+
+	return a.rankByStats(strategy, request.SocialDemoID, candidates, 1, data.stats[request.SlotID])
+}
@@ -0,0 +1,121 @@
+package app
+
+import (
+	"sort"
+
+	"github.com/Fuchsoria/banners-rotation/internal/bandit"
+)
+
+type SelectionExplanation struct {
+	Strategy string
+	// BySocialDemo is false when social demo group has less than SocialDemoMinViews views
+	// and statistics of whole slot are used.
+	BySocialDemo bool
+	Candidates   []Candidate
+}
+
+type Candidate struct {
+	BannerID string
+	bandit.Explanation
+}
+
+// ExplainSelection returns candidates of slot ordered by score of slot strategy with
+// statistics and score parts strategy uses for social demo group, view is not recorded.
+// It accepts the same requests as PreviewBanner which selection it explains.
+func (a *App) ExplainSelection(slotID string, socialDemoID string, features map[string]float64) (SelectionExplanation, error) {
+	strategy, err := a.GetSlotStrategy(slotID)
+	if err != nil {
+		return SelectionExplanation{}, err
+	}
+
+	banners, err := a.getBannersInSlot(slotID)
+	if err != nil {
+		return SelectionExplanation{}, err
+	}
+
+	explanations, bySocialDemo, err := a.explainBanners(strategy, slotID, socialDemoID, banners, features)
+	if err != nil {
+		return SelectionExplanation{}, err
+	}
+
+	candidates := make([]Candidate, 0, len(banners))
+
+	for _, banner := range banners {
+		candidates = append(candidates, Candidate{BannerID: banner, Explanation: explanations[banner]})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Score > candidates[j].Score })
+
+	return SelectionExplanation{Strategy: a.bandit.Resolve(strategy), BySocialDemo: bySocialDemo, Candidates: candidates}, nil
+}
+
+func (a *App) explainBanners(
+	strategy string,
+	slotID string,
+	socialDemoID string,
+	banners []string,
+	features map[string]float64,
+) (map[string]bandit.Explanation, bool, error) {
+	if timeAware, ok := a.bandit.GetTimeAware(strategy); ok {
+		now := a.settings.Clock()
+
+		buckets, bySocialDemo, err := a.timeAwareBuckets(timeAware, slotID, socialDemoID, now)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if explainer, ok := timeAware.(bandit.TimeAwareExplainer); ok {
+			return explainer.Explain(banners, buckets, now), bySocialDemo, nil
+		}
+
+		explanations := make(map[string]bandit.Explanation, len(banners))
+
+		for _, bucket := range buckets {
+			explanation := explanations[bucket.Item]
+			explanation.Views += float64(bucket.Views)
+			explanation.Clicks += float64(bucket.Clicks)
+			explanations[bucket.Item] = explanation
+		}
+
+		return withScores(explanations, timeAware.GetScores(banners, buckets, now)), bySocialDemo, nil
+	}
+
+	stats, err := a.storage.GetSlotStats(slotID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	clicks, views, bySocialDemo := a.socialDemoCounters(socialDemoID, stats)
+
+	if contextual, ok := a.bandit.GetContextual(strategy); ok {
+		scores, err := contextual.GetScores(slotID, banners, features)
+		if err != nil {
+			return nil, false, err
+		}
+
+		explanations := make(map[string]bandit.Explanation, len(banners))
+
+		for _, banner := range banners {
+			explanations[banner] = bandit.Explanation{Views: float64(views[banner]), Clicks: float64(clicks[banner])}
+		}
+
+		return withScores(explanations, scores), bySocialDemo, nil
+	}
+
+	explanations, err := a.bandit.Explain(strategy, banners, clicks, views)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return explanations, bySocialDemo, nil
+}
+
+func withScores(explanations map[string]bandit.Explanation, scores map[string]float64) map[string]bandit.Explanation {
+	for item, score := range scores {
+		explanation := explanations[item]
+		explanation.Score = score
+		explanations[item] = explanation
+	}
+
+	return explanations
+}
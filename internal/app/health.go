@@ -0,0 +1,26 @@
+package app
+
+import simpleproducer "github.com/Fuchsoria/banners-rotation/internal/amqp/producer"
+
+const (
+	HealthOK = "ok"
+	// HealthDegraded is status while amqp is not connected, events are kept in outbox and published later.
+	HealthDegraded = "degraded"
+)
+
+// Health is state of service dependencies.
+type Health struct {
+	Status string               `json:"status"`
+	AMQP   simpleproducer.State `json:"amqp"`
+	Events PublishStats         `json:"events"`
+}
+
+func (a *App) Health() Health {
+	health := Health{Status: HealthOK, AMQP: a.producer.State(), Events: a.PublishStats()}
+
+	if health.AMQP != simpleproducer.StateConnected {
+		health.Status = HealthDegraded
+	}
+
+	return health
+}
@@ -0,0 +1,173 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Fuchsoria/banners-rotation/internal/impression"
+	"github.com/Fuchsoria/banners-rotation/internal/storage"
+)
+
+var (
+	ErrImpressionTokensDisabled = errors.New("impression tokens are disabled")
+	ErrImpressionTokenRequired  = errors.New("click should have impression token")
+	ErrImpressionMismatch       = errors.New("click does not match impression of token")
+	ErrServedImpression         = errors.New("view of served impression is recorded on selection")
+	ErrImpressionNotViewed      = errors.New("preview impression is not viewed")
+)
+
+// ImpressionTokens returns token of kind for every shown banner of slot in the same order,
+// tokens are empty when they are disabled. Selections which record views give served tokens
+// and dry run selections give preview tokens, features of selection are kept in tokens.
+func (a *App) ImpressionTokens(
+	kind impression.Kind,
+	slotID string,
+	socialDemoID string,
+	bannerIDs []string,
+	features map[string]float64,
+) ([]string, error) {
+	tokens := make([]string, len(bannerIDs))
+
+	if a.impressions == nil {
+		return tokens, nil
+	}
+
+	now := a.settings.Clock()
+
+	for i, bannerID := range bannerIDs {
+		token, err := a.impressions.Sign(kind, slotID, bannerID, socialDemoID, features, now)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens[i] = token
+	}
+
+	return tokens, nil
+}
+
+// AddImpressionClickEvent adds click of impression token, empty ids are taken from token
+// and not empty ones should match it. Every impression can be clicked once,
+// preview impression can be clicked after its view is recorded.
+// Features of token are used instead of click features when token has them.
+func (a *App) AddImpressionClickEvent(
+	token string,
+	bannerID string,
+	slotID string,
+	socialDemoID string,
+	features map[string]float64,
+) error {
+	if a.impressions == nil {
+		return ErrImpressionTokensDisabled
+	}
+
+	date := a.settings.Clock()
+
+	served, err := a.impressions.Parse(token, date)
+	if err != nil {
+		return err
+	}
+
+	if (bannerID != "" && bannerID != served.BannerID) ||
+		(slotID != "" && slotID != served.SlotID) ||
+		(socialDemoID != "" && socialDemoID != served.SocialDemoID) {
+		return ErrImpressionMismatch
+	}
+
+	return a.addImpressionClick(served, features, date)
+}
+
+// ClickImpression adds click of impression token and returns target url of its banner,
+// click of expired, already clicked or not viewed preview impression is not added but its url is still returned.
+func (a *App) ClickImpression(token string) (string, error) {
+	if a.impressions == nil {
+		return "", ErrImpressionTokensDisabled
+	}
+
+	date := a.settings.Clock()
+
+	served, err := a.impressions.Parse(token, date)
+	if err == nil {
+		err = a.addImpressionClick(served, nil, date)
+	}
+
+	if errors.Is(err, impression.ErrExpiredToken) || errors.Is(err, storage.ErrAlreadyExists) ||
+		errors.Is(err, ErrImpressionNotViewed) {
+		a.logger.Warn("click is not recorded", "impression", served.ID, "error", err.Error())
+	} else if err != nil {
+		return "", err
+	}
+
+	banner, err := a.storage.GetBannerItem(served.BannerID)
+	if err != nil {
+		return "", err
+	}
+
+	if banner.TargetURL == "" {
+		return "", fmt.Errorf("banner %q has no target url, %w", banner.ID, storage.ErrNotFound)
+	}
+
+	return banner.TargetURL, nil
+}
+
+// AddImpressionViewEvent adds view of preview impression token, every impression is viewed once.
+// View of served impression is already recorded by its selection, so it gives ErrServedImpression.
+func (a *App) AddImpressionViewEvent(token string) error {
+	if a.impressions == nil {
+		return ErrImpressionTokensDisabled
+	}
+
+	date := a.settings.Clock()
+
+	served, err := a.impressions.Parse(token, date)
+	if err != nil {
+		return err
+	}
+
+	if served.Kind != impression.KindPreview {
+		return ErrServedImpression
+	}
+
+	err = a.storage.AddImpressionViewEvent(served.ID, served.BannerID, served.SlotID, served.SocialDemoID, date)
+	if err != nil {
+		return fmt.Errorf("cannot create banner view event, %w", err)
+	}
+
+	return a.viewed(served.BannerID, served.SlotID, served.Features)
+}
+
+func (a *App) addImpressionClick(served impression.Impression, features map[string]float64, date time.Time) error {
+	if served.Kind == impression.KindPreview {
+		viewed, err := a.storage.HasImpressionView(served.ID)
+		if err != nil {
+			return fmt.Errorf("cannot check view of impression, %w", err)
+		}
+
+		if !viewed {
+			return ErrImpressionNotViewed
+		}
+	}
+
+	err := a.storage.AddImpressionClickEvent(served.ID, served.BannerID, served.SlotID, served.SocialDemoID, date)
+	if err != nil {
+		return fmt.Errorf("cannot create banner click event, %w", err)
+	}
+
+	if len(served.Features) > 0 {
+		features = served.Features
+	}
+
+	return a.clicked(served.BannerID, served.SlotID, served.SocialDemoID, features, date)
+}
+
+// IsImpressionError reports whether err is caused by invalid, expired or mismatched impression token.
+func IsImpressionError(err error) bool {
+	return errors.Is(err, impression.ErrInvalidToken) ||
+		errors.Is(err, impression.ErrExpiredToken) ||
+		errors.Is(err, ErrImpressionMismatch) ||
+		errors.Is(err, ErrImpressionTokenRequired) ||
+		errors.Is(err, ErrServedImpression) ||
+		errors.Is(err, ErrImpressionNotViewed) ||
+		errors.Is(err, ErrImpressionTokensDisabled)
+}
@@ -0,0 +1,188 @@
+package app
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+
+	sqlstorage "github.com/Fuchsoria/banners-rotation/internal/storage/sql"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 1000
+	// MaxBannersCount limits banners requested from slot at once.
+	MaxBannersCount = 100
+)
+
+var (
+	ErrInvalidPageToken = errors.New("invalid page token")
+	ErrInvalidTargetURL = errors.New("target url should be absolute http or https url")
+)
+
+// Page tokens keep id of the last item on previous page, items are listed in order of id.
+func encodePageToken(lastID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(lastID))
+}
+
+func decodePageToken(pageToken string) (string, error) {
+	lastID, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return "", fmt.Errorf("%s, %w", err, ErrInvalidPageToken)
+	}
+
+	return string(lastID), nil
+}
+
+func pageLimit(pageSize int) int {
+	if pageSize <= 0 {
+		return DefaultPageSize
+	}
+
+	if pageSize > MaxPageSize {
+		return MaxPageSize
+	}
+
+	return pageSize
+}
+
+func (a *App) ReadBanner(id string) (sqlstorage.BannerItem, error) {
+	return a.storage.GetBannerItem(id)
+}
+
+// ListBanners returns page of banners and token of the next page, token is empty on the last page.
+func (a *App) ListBanners(pageSize int, pageToken string) ([]sqlstorage.BannerItem, string, error) {
+	afterID, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	limit := pageLimit(pageSize)
+
+	banners, err := a.storage.ListBanners(afterID, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(banners) <= limit {
+		return banners, "", nil
+	}
+
+	banners = banners[:limit]
+
+	return banners, encodePageToken(banners[limit-1].ID), nil
+}
+
+// UpdateBanner changes fields which are not nil, other fields are kept.
+func (a *App) UpdateBanner(id string, description *string, targetURL *string) error {
+	if targetURL != nil {
+		if err := validateTargetURL(*targetURL); err != nil {
+			return fmt.Errorf("cannot update banner, %w", err)
+		}
+	}
+
+	return a.storage.UpdateBanner(id, description, targetURL)
+}
+
+// validateTargetURL allows empty url of banner without link and absolute http or https urls,
+// so click redirect can't lead to scripts or other schemes.
+func validateTargetURL(targetURL string) error {
+	if targetURL == "" {
+		return nil
+	}
+
+	parsed, err := url.Parse(targetURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("%q, %w", targetURL, ErrInvalidTargetURL)
+	}
+
+	return nil
+}
+
+func (a *App) DeleteBanner(id string) error {
+	return a.storage.DeleteBanner(id)
+}
+
+func (a *App) ReadSlot(id string) (sqlstorage.SlotItem, error) {
+	return a.storage.GetSlotItem(id)
+}
+
+// ListSlots returns page of slots and token of the next page, token is empty on the last page.
+func (a *App) ListSlots(pageSize int, pageToken string) ([]sqlstorage.SlotItem, string, error) {
+	afterID, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	limit := pageLimit(pageSize)
+
+	slots, err := a.storage.ListSlots(afterID, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(slots) <= limit {
+		return slots, "", nil
+	}
+
+	slots = slots[:limit]
+
+	return slots, encodePageToken(slots[limit-1].ID), nil
+}
+
+// UpdateSlot changes fields which are not nil, other fields are kept.
+func (a *App) UpdateSlot(id string, description *string, strategy *string) error {
+	if strategy != nil {
+		if err := a.bandit.Validate(*strategy); err != nil {
+			return fmt.Errorf("cannot update slot, %w", err)
+		}
+	}
+
+	return a.storage.UpdateSlot(id, description, strategy)
+}
+
+func (a *App) DeleteSlot(id string) error {
+	if err := a.storage.DeleteSlot(id); err != nil {
+		return err
+	}
+
+	a.bandit.ForgetSlot(id)
+
+	return nil
+}
+
+func (a *App) ReadSocialDemo(id string) (sqlstorage.SocialDemoItem, error) {
+	return a.storage.GetSocialDemoItem(id)
+}
+
+// ListSocialDemos returns page of social demos and token of the next page, token is empty on the last page.
+func (a *App) ListSocialDemos(pageSize int, pageToken string) ([]sqlstorage.SocialDemoItem, string, error) {
+	afterID, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	limit := pageLimit(pageSize)
+
+	socialDemos, err := a.storage.ListSocialDemos(afterID, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(socialDemos) <= limit {
+		return socialDemos, "", nil
+	}
+
+	socialDemos = socialDemos[:limit]
+
+	return socialDemos, encodePageToken(socialDemos[limit-1].ID), nil
+}
+
+func (a *App) UpdateSocialDemo(id string, description string) error {
+	return a.storage.UpdateSocialDemo(id, description)
+}
+
+func (a *App) DeleteSocialDemo(id string) error {
+	return a.storage.DeleteSocialDemo(id)
+}
@@ -0,0 +1,55 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	simpleproducer "github.com/Fuchsoria/banners-rotation/internal/amqp/producer"
+	sqlstorage "github.com/Fuchsoria/banners-rotation/internal/storage/sql"
+)
+
+// RelayOutbox publishes a batch of pending events in order until publishing fails,
+// published ones are removed, so every event is published at least once.
+// Message has id of outbox row, so consumers can skip events which are published again.
+// Nothing is published while producer is not connected, events wait in outbox meanwhile.
+func (a *App) RelayOutbox() (int, error) {
+	if a.producer.State() != simpleproducer.StateConnected {
+		return 0, nil
+	}
+
+	settings := a.settings
+
+	return a.storage.PublishOutbox(settings.OutboxBatch, settings.Clock(), settings.OutboxClaim, func(row sqlstorage.OutboxRow) error {
+		return a.publish(simpleproducer.AMQPMessage{
+			ID:           row.ID,
+			Type:         row.Type,
+			SlotID:       row.SlotID,
+			BannerID:     row.BannerID,
+			SocialDemoID: row.SocialDemoID,
+			Date:         row.Date,
+		})
+	})
+}
+
+// RunOutboxRelay relays outbox until ctx is done, the next batch is published right away
+// while batches are full, otherwise relay waits for OutboxInterval. Failed batch is logged once
+// with the event which is not published.
+func (a *App) RunOutboxRelay(ctx context.Context) {
+	for {
+		sent, err := a.RelayOutbox()
+		if err != nil {
+			a.logger.Warn("outbox relay is paused", "sent", sent, "error", err.Error())
+		}
+
+		delay := a.settings.OutboxInterval
+		if err == nil && sent == a.settings.OutboxBatch {
+			delay = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	simpleproducer "github.com/Fuchsoria/banners-rotation/internal/amqp/producer"
+)
+
+// PublishStats counts outcomes of publishing attempts since start, failed attempt of relay batch
+// is counted once and its events are published again by the next relay run, so they are delayed, not lost.
+type PublishStats struct {
+	Published  uint64 `json:"published"`
+	Nacked     uint64 `json:"nacked"`
+	Unroutable uint64 `json:"unroutable"`
+	TimedOut   uint64 `json:"timed_out"`
+	Failed     uint64 `json:"failed"`
+}
+
+type publishCounters struct {
+	published, nacked, unroutable, timedOut, failed uint64
+}
+
+func (c *publishCounters) stats() PublishStats {
+	return PublishStats{
+		Published:  atomic.LoadUint64(&c.published),
+		Nacked:     atomic.LoadUint64(&c.nacked),
+		Unroutable: atomic.LoadUint64(&c.unroutable),
+		TimedOut:   atomic.LoadUint64(&c.timedOut),
+		Failed:     atomic.LoadUint64(&c.failed),
+	}
+}
+
+// publish sends event to producer and counts outcome of the attempt, failures are logged by relay.
+func (a *App) publish(message simpleproducer.AMQPMessage) error {
+	err := a.producer.Publish(message)
+
+	counter := &a.publishing.failed
+
+	switch {
+	case err == nil:
+		atomic.AddUint64(&a.publishing.published, 1)
+
+		return nil
+	case errors.Is(err, simpleproducer.ErrNacked):
+		counter = &a.publishing.nacked
+	case errors.Is(err, simpleproducer.ErrUnroutable):
+		counter = &a.publishing.unroutable
+	case errors.Is(err, simpleproducer.ErrConfirmTimeout):
+		counter = &a.publishing.timedOut
+	}
+
+	atomic.AddUint64(counter, 1)
+
+	return fmt.Errorf("cannot publish %s event %d of slot %q, %w", message.Type, message.ID, message.SlotID, err)
+}
+
+// PublishStats returns counters of publishing attempts outcomes.
+func (a *App) PublishStats() PublishStats {
+	return a.publishing.stats()
+}
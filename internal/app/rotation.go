@@ -0,0 +1,137 @@
+package app
+
+type RotationBanner struct {
+	BannerID    string
+	Description string
+	Views       int
+	Clicks      int
+	CTR         float64
+	Score       float64
+}
+
+type BannerSlot struct {
+	SlotID      string
+	Description string
+	Strategy    string
+	Views       int
+	Clicks      int
+	CTR         float64
+	Score       float64
+}
+
+// GetSlotRotation returns banners of slot with statistics of all social demos
+// and scores of slot strategy, contextual strategies are scored without request features.
+func (a *App) GetSlotRotation(slotID string) (string, []RotationBanner, error) {
+	if _, err := a.storage.GetSlotItem(slotID); err != nil {
+		return "", nil, err
+	}
+
+	strategy, err := a.GetSlotStrategy(slotID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	banners, err := a.storage.GetSlotRotation(slotID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	stats, err := a.storage.GetSlotStats(slotID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	items := make([]string, 0, len(banners))
+
+	for _, banner := range banners {
+		items = append(items, banner.ID)
+	}
+
+	_, clicks, views := a.MapDataFromDB(nil, stats)
+
+	scores, err := a.scoreBanners(strategy, slotID, items, clicks, views)
+	if err != nil {
+		return "", nil, err
+	}
+
+	rotation := make([]RotationBanner, 0, len(banners))
+
+	for _, banner := range banners {
+		rotation = append(rotation, RotationBanner{
+			BannerID:    banner.ID,
+			Description: banner.Description,
+			Views:       views[banner.ID],
+			Clicks:      clicks[banner.ID],
+			CTR:         clickRate(clicks[banner.ID], views[banner.ID]),
+			Score:       scores[banner.ID],
+		})
+	}
+
+	return strategy, rotation, nil
+}
+
+// GetBannerSlots returns slots which rotate banner with its statistics and score in every slot.
+func (a *App) GetBannerSlots(bannerID string) ([]BannerSlot, error) {
+	if _, err := a.storage.GetBannerItem(bannerID); err != nil {
+		return nil, err
+	}
+
+	slots, err := a.storage.GetBannerSlots(bannerID)
+	if err != nil {
+		return nil, err
+	}
+
+	bannerSlots := make([]BannerSlot, 0, len(slots))
+
+	for _, slot := range slots {
+		strategy, rotation, err := a.GetSlotRotation(slot.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, banner := range rotation {
+			if banner.BannerID != bannerID {
+				continue
+			}
+
+			bannerSlots = append(bannerSlots, BannerSlot{
+				SlotID:      slot.ID,
+				Description: slot.Description,
+				Strategy:    strategy,
+				Views:       banner.Views,
+				Clicks:      banner.Clicks,
+				CTR:         banner.CTR,
+				Score:       banner.Score,
+			})
+		}
+	}
+
+	return bannerSlots, nil
+}
+
+func (a *App) scoreBanners(strategy string, slotID string, banners []string, clicks map[string]int, views map[string]int) (map[string]float64, error) {
+	if contextual, ok := a.bandit.GetContextual(strategy); ok {
+		return contextual.GetScores(slotID, banners, nil)
+	}
+
+	if timeAware, ok := a.bandit.GetTimeAware(strategy); ok {
+		now := a.settings.Clock()
+
+		stats, err := a.storage.GetBannersTimeStats(slotID, timeAware.Since(now))
+		if err != nil {
+			return nil, err
+		}
+
+		return timeAware.GetScores(banners, timeBuckets(stats), now), nil
+	}
+
+	return a.bandit.Scores(strategy, banners, clicks, views)
+}
+
+func clickRate(clicks int, views int) float64 {
+	if views == 0 {
+		return 0
+	}
+
+	return float64(clicks) / float64(views)
+}
@@ -0,0 +1,314 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Fuchsoria/banners-rotation/internal/bandit"
+	"github.com/Fuchsoria/banners-rotation/internal/storage"
+	sqlstorage "github.com/Fuchsoria/banners-rotation/internal/storage/sql"
+)
+
+var ErrUnexpectedBanner = errors.New("bandit returned banner which is not a candidate")
+
+func (a *App) GetBanner(slotID string, socialDemoID string, features map[string]float64) (string, error) {
+	bannerIDs, err := a.GetBanners(slotID, socialDemoID, 1, features)
+	if err != nil {
+		return "", err
+	}
+
+	return bannerIDs[0], nil
+}
+
+// PreviewBanner selects banner like GetBanner but does not record view,
+// RecordImpression should be called when banner is actually shown.
+func (a *App) PreviewBanner(slotID string, socialDemoID string, features map[string]float64) (string, error) {
+	bannerIDs, err := a.PreviewBanners(slotID, socialDemoID, 1, features)
+	if err != nil {
+		return "", err
+	}
+
+	return bannerIDs[0], nil
+}
+
+// GetBanners returns up to count distinct banners from slot in order of bandit preference
+// and adds view event for every one of them.
+func (a *App) GetBanners(slotID string, socialDemoID string, count int, features map[string]float64) ([]string, error) {
+	return a.getBanners(slotID, socialDemoID, count, features, true)
+}
+
+// PreviewBanners selects banners like GetBanners but does not record views.
+func (a *App) PreviewBanners(slotID string, socialDemoID string, count int, features map[string]float64) ([]string, error) {
+	return a.getBanners(slotID, socialDemoID, count, features, false)
+}
+
+// RecordImpression adds view event of banner which was selected in dry run mode and is actually shown.
+func (a *App) RecordImpression(bannerID string, slotID string, socialDemoID string, features map[string]float64) error {
+	strategy, err := a.GetSlotStrategy(slotID)
+	if err != nil {
+		return err
+	}
+
+	banners, err := a.getBannersInSlot(slotID)
+	if err != nil {
+		return err
+	}
+
+	for _, banner := range banners {
+		if banner == bannerID {
+			return a.addBannerView(strategy, bannerID, slotID, socialDemoID, features)
+		}
+	}
+
+	return fmt.Errorf("banner %q is not in rotation of slot %q, %w", bannerID, slotID, storage.ErrNotFound)
+}
+
+func (a *App) getBanners(slotID string, socialDemoID string, count int, features map[string]float64, record bool) ([]string, error) {
+	strategy, err := a.GetSlotStrategy(slotID)
+	if err != nil {
+		return nil, err
+	}
+
+	banners, err := a.getBannersInSlot(slotID)
+	if err != nil {
+		return nil, err
+	}
+
+	bannerIDs, err := a.selectBanners(strategy, slotID, socialDemoID, banners, count, features)
+	if err != nil {
+		return nil, err
+	}
+
+	if !record {
+		return bannerIDs, nil
+	}
+
+	for _, bannerID := range bannerIDs {
+		if err := a.addBannerView(strategy, bannerID, slotID, socialDemoID, features); err != nil {
+			return nil, err
+		}
+	}
+
+	return bannerIDs, nil
+}
+
+func (a *App) selectBanners(
+	strategy string,
+	slotID string,
+	socialDemoID string,
+	banners []string,
+	count int,
+	features map[string]float64,
+) ([]string, error) {
+	if contextual, ok := a.bandit.GetContextual(strategy); ok {
+		return rankBanners(banners, nil, count, func(banners []string) (string, error) {
+			return contextual.Use(slotID, banners, features)
+		})
+	}
+
+	if timeAware, ok := a.bandit.GetTimeAware(strategy); ok {
+		return a.SelectTimeAwareBanners(timeAware, slotID, socialDemoID, banners, count)
+	}
+
+	return a.SelectBanners(strategy, slotID, socialDemoID, banners, count)
+}
+
+func (a *App) addBannerView(strategy string, bannerID string, slotID string, socialDemoID string, features map[string]float64) error {
+	err := a.AddViewEvent(bannerID, slotID, socialDemoID)
+	if err != nil {
+		return err
+	}
+
+	if contextual, ok := a.bandit.GetContextual(strategy); ok {
+		if err := contextual.AddView(slotID, bannerID, features); err != nil {
+			return fmt.Errorf("cannot update contextual bandit with view, %w", err)
+		}
+	}
+
+	return nil
+}
+
+// SelectTimeAwareBanners uses hourly statistics of social demo group in slot,
+// groups with less than SocialDemoMinViews views in statistics period fall back to statistics of whole slot.
+func (a *App) SelectTimeAwareBanners(
+	timeAware bandit.TimeAwareStrategy,
+	slotID string,
+	socialDemoID string,
+	banners []string,
+	count int,
+) ([]string, error) {
+	now := a.settings.Clock()
+
+	buckets, _, err := a.timeAwareBuckets(timeAware, slotID, socialDemoID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return rankBanners(banners, nil, count, func(banners []string) (string, error) {
+		return timeAware.Use(banners, buckets, now)
+	})
+}
+
+// timeAwareBuckets returns hourly statistics of social demo group or of whole slot when group has not enough views.
+func (a *App) timeAwareBuckets(
+	timeAware bandit.TimeAwareStrategy,
+	slotID string,
+	socialDemoID string,
+	now time.Time,
+) (buckets []bandit.TimeBucket, bySocialDemo bool, err error) {
+	since := timeAware.Since(now)
+
+	stats, err := a.storage.GetBannersTimeStatsBySocialDemo(slotID, socialDemoID, since)
+	if err != nil {
+		return nil, false, err
+	}
+
+	views := 0
+
+	for _, item := range stats {
+		views += item.Views
+	}
+
+	if views < a.settings.SocialDemoMinViews {
+		stats, err = a.storage.GetBannersTimeStats(slotID, since)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return timeBuckets(stats), false, nil
+	}
+
+	return timeBuckets(stats), true, nil
+}
+
+func timeBuckets(stats []sqlstorage.TimeStatsItem) []bandit.TimeBucket {
+	buckets := make([]bandit.TimeBucket, 0, len(stats))
+
+	for _, item := range stats {
+		buckets = append(buckets, bandit.TimeBucket{Item: item.BannerID, Time: item.Hour, Views: item.Views, Clicks: item.Clicks})
+	}
+
+	return buckets
+}
+
+// SelectBanners runs bandit on statistics of social demo group in slot,
+// groups with less than SocialDemoMinViews views fall back to statistics of whole slot.
+func (a *App) SelectBanners(strategy string, slotID string, socialDemoID string, banners []string, count int) ([]string, error) {
+	bannersStats, err := a.storage.GetSlotStats(slotID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.rankByStats(strategy, socialDemoID, banners, count, bannersStats)
+}
+
+func (a *App) rankByStats(strategy string, socialDemoID string, banners []string, count int, bannersStats []sqlstorage.StatsItem) ([]string, error) {
+	mappedBannersClicks, mappedBannersViews, _ := a.socialDemoCounters(socialDemoID, bannersStats)
+	notViewed := []string{}
+
+	for _, banner := range banners {
+		if mappedBannersViews[banner] == 0 {
+			notViewed = append(notViewed, banner)
+		}
+	}
+
+	return rankBanners(banners, notViewed, count, func(banners []string) (string, error) {
+		return a.bandit.Use(strategy, banners, mappedBannersClicks, mappedBannersViews)
+	})
+}
+
+// socialDemoCounters returns clicks and views of social demo group or of whole slot when group has not enough views.
+func (a *App) socialDemoCounters(
+	socialDemoID string,
+	bannersStats []sqlstorage.StatsItem,
+) (clicks map[string]int, views map[string]int, bySocialDemo bool) {
+	socialDemoStats := make([]sqlstorage.StatsItem, 0, len(bannersStats))
+	socialDemoViews := 0
+
+	for _, item := range bannersStats {
+		if item.SocialDemoID == socialDemoID {
+			socialDemoStats = append(socialDemoStats, item)
+			socialDemoViews += item.Views
+		}
+	}
+
+	bySocialDemo = socialDemoViews >= a.settings.SocialDemoMinViews
+	if bySocialDemo {
+		bannersStats = socialDemoStats
+	}
+
+	_, clicks, views = a.MapDataFromDB(nil, bannersStats)
+
+	return clicks, views, bySocialDemo
+}
+
+func (a *App) getBannersInSlot(slotID string) ([]string, error) {
+	bannersInSlot, err := a.storage.GetBannersInSlot(slotID)
+	if err != nil {
+		return nil, err
+	}
+
+	banners := make([]string, 0, len(bannersInSlot))
+
+	for _, banner := range bannersInSlot {
+		banners = append(banners, banner.BannerID)
+	}
+
+	return banners, nil
+}
+
+// rankBanners takes not viewed banners first and then asks bandit for the best of remaining banners
+// position by position, so exploration is applied for every position.
+func rankBanners(banners []string, notViewed []string, count int, use func(banners []string) (string, error)) ([]string, error) {
+	if len(banners) == 0 {
+		return nil, bandit.ErrEmptySlice
+	}
+
+	capacity := count
+	if capacity > len(banners) {
+		capacity = len(banners)
+	}
+
+	selected := make([]string, 0, capacity)
+	remaining := make(map[string]bool, len(banners))
+
+	for _, banner := range banners {
+		remaining[banner] = true
+	}
+
+	for _, banner := range notViewed {
+		if len(selected) == count {
+			break
+		}
+
+		if remaining[banner] {
+			selected = append(selected, banner)
+			delete(remaining, banner)
+		}
+	}
+
+	for len(selected) < count && len(remaining) > 0 {
+		candidates := make([]string, 0, len(remaining))
+
+		for _, banner := range banners {
+			if remaining[banner] {
+				candidates = append(candidates, banner)
+			}
+		}
+
+		bannerID, err := use(candidates)
+		if err != nil {
+			return nil, err
+		}
+
+		if !remaining[bannerID] {
+			return nil, fmt.Errorf("%q, %w", bannerID, ErrUnexpectedBanner)
+		}
+
+		selected = append(selected, bannerID)
+		delete(remaining, bannerID)
+	}
+
+	return selected, nil
+}
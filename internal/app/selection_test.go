@@ -0,0 +1,62 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/Fuchsoria/banners-rotation/internal/bandit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankBanners(t *testing.T) {
+	banners := []string{"banner1", "banner2", "banner3", "banner4"}
+	scores := map[string]int{"banner1": 1, "banner2": 4, "banner3": 3, "banner4": 2}
+
+	best := func(banners []string) (string, error) {
+		top := banners[0]
+
+		for _, banner := range banners {
+			if scores[banner] > scores[top] {
+				top = banner
+			}
+		}
+
+		return top, nil
+	}
+
+	t.Run("test ranked selection", func(t *testing.T) {
+		selected, err := rankBanners(banners, nil, 3, best)
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"banner2", "banner3", "banner4"}, selected)
+	})
+
+	t.Run("test not viewed banners first", func(t *testing.T) {
+		selected, err := rankBanners(banners, []string{"banner1"}, 2, best)
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"banner1", "banner2"}, selected)
+	})
+
+	t.Run("test count greater than banners", func(t *testing.T) {
+		selected, err := rankBanners(banners, []string{"banner4"}, 10, best)
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"banner4", "banner2", "banner3", "banner1"}, selected)
+	})
+
+	t.Run("test empty slot", func(t *testing.T) {
+		selected, err := rankBanners(nil, nil, 1, best)
+
+		require.ErrorIs(t, err, bandit.ErrEmptySlice)
+		require.Empty(t, selected)
+	})
+
+	t.Run("test unexpected banner", func(t *testing.T) {
+		selected, err := rankBanners(banners, nil, 1, func(banners []string) (string, error) {
+			return "banner5", nil
+		})
+
+		require.ErrorIs(t, err, ErrUnexpectedBanner)
+		require.Empty(t, selected)
+	})
+}
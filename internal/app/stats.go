@@ -0,0 +1,57 @@
+package app
+
+import (
+	"time"
+
+	sqlstorage "github.com/Fuchsoria/banners-rotation/internal/storage/sql"
+)
+
+type StatsRow struct {
+	SlotID       string
+	BannerID     string
+	SocialDemoID string
+	Time         *time.Time
+	Views        int
+	Clicks       int
+	CTR          float64
+}
+
+// GetStats returns views, clicks and CTR grouped by query fields and time bucket.
+func (a *App) GetStats(query sqlstorage.StatsQuery) ([]StatsRow, error) {
+	rows, err := a.storage.GetStats(query)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]StatsRow, 0, len(rows))
+
+	for _, row := range rows {
+		stats = append(stats, newStatsRow(row))
+	}
+
+	return stats, nil
+}
+
+// ExportStats passes GetStats rows to fn without loading all of them in memory.
+func (a *App) ExportStats(query sqlstorage.StatsQuery, fn func(row StatsRow) error) error {
+	return a.storage.StreamStats(query, func(row sqlstorage.StatsRow) error {
+		return fn(newStatsRow(row))
+	})
+}
+
+// ExportEvents passes raw clicks and views of query range ordered by date to fn.
+func (a *App) ExportEvents(query sqlstorage.StatsQuery, fn func(row sqlstorage.EventRow) error) error {
+	return a.storage.StreamEvents(query, fn)
+}
+
+func newStatsRow(row sqlstorage.StatsRow) StatsRow {
+	return StatsRow{
+		SlotID:       row.SlotID,
+		BannerID:     row.BannerID,
+		SocialDemoID: row.SocialDemoID,
+		Time:         row.Time,
+		Views:        row.Views,
+		Clicks:       row.Clicks,
+		CTR:          clickRate(row.Clicks, row.Views),
+	}
+}
@@ -13,11 +13,76 @@ var (
 	ErrNoViewsForItem = errors.New("some items does not have views")
 )
 
+// Explanation is statistics and score strategy ranks item by, exploitation and exploration
+// are the halves of UCB1 score and they are zero for other strategies.
+type Explanation struct {
+	Views        float64
+	Clicks       float64
+	Exploitation float64
+	Exploration  float64
+	Score        float64
+}
+
 func (b *Bandit) GetScore(viewsCount float64, clicksCount float64, totalUses float64) float64 {
-	clickViewRate := clicksCount / viewsCount
-	banditRate := math.Sqrt(((2 * math.Log(totalUses)) / viewsCount))
+	return b.GetExploitation(viewsCount, clicksCount) + b.GetExploration(viewsCount, totalUses)
+}
+
+// GetExploitation is click rate of item.
+func (b *Bandit) GetExploitation(viewsCount float64, clicksCount float64) float64 {
+	return clicksCount / viewsCount
+}
+
+// GetExploration is confidence bonus of item which is bigger for rarely viewed items.
+func (b *Bandit) GetExploration(viewsCount float64, totalUses float64) float64 {
+	return math.Sqrt(((2 * math.Log(totalUses)) / viewsCount))
+}
+
+// Explain returns parts of scores Use ranks items by.
+func (b *Bandit) Explain(items []string, clicks map[string]int, views map[string]int) map[string]Explanation {
+	weightedClicks := make(map[string]float64, len(clicks))
+	weightedViews := make(map[string]float64, len(views))
+
+	for item, count := range clicks {
+		weightedClicks[item] = float64(count)
+	}
+
+	for item, count := range views {
+		weightedViews[item] = float64(count)
+	}
+
+	return b.explain(items, weightedClicks, weightedViews, float64(len(views)))
+}
+
+// ExplainWeighted returns parts of scores UseWeighted ranks items by.
+func (b *Bandit) ExplainWeighted(items []string, clicks map[string]float64, views map[string]float64) map[string]Explanation {
+	totalUses := 0.0
+
+	for _, item := range items {
+		totalUses += views[item]
+	}
+
+	return b.explain(items, clicks, views, math.Max(totalUses, 1))
+}
+
+func (b *Bandit) explain(items []string, clicks map[string]float64, views map[string]float64, totalUses float64) map[string]Explanation {
+	explanations := make(map[string]Explanation, len(items))
+
+	for _, item := range items {
+		explanation := Explanation{Views: views[item], Clicks: clicks[item]}
+
+		if views[item] == 0 {
+			explanation.Exploration = math.Inf(1)
+			explanation.Score = math.Inf(1)
+		} else {
+			explanation.Exploitation = b.GetExploitation(views[item], clicks[item])
+			explanation.Exploration = b.GetExploration(views[item], totalUses)
+			explanation.Score = explanation.Exploitation + explanation.Exploration
+		}
+
+		explanations[item] = explanation
+	}
 
-	return clickViewRate + banditRate
+	return explanations
 }
 
 func (b *Bandit) GetTopScore(scores map[string]float64) float64 {
@@ -73,17 +138,67 @@ func (b *Bandit) Use(items []string, clicks map[string]int, views map[string]int
 		return "", err
 	}
 
+	itemsScore := b.GetScores(items, clicks, views)
+
+	topScore := b.GetTopScore(itemsScore)
+	itemsWithTopScore := b.GetItemsWithTopScore(itemsScore, topScore)
+	itemID := b.GetRandomItemFromTop(itemsWithTopScore)
+
+	return itemID, nil
+}
+
+// GetScores returns scores Use ranks items by, items without views get +Inf score.
+func (b *Bandit) GetScores(items []string, clicks map[string]int, views map[string]int) map[string]float64 {
 	itemsScore := make(map[string]float64)
 
 	for _, item := range items {
+		if views[item] == 0 {
+			itemsScore[item] = math.Inf(1)
+
+			continue
+		}
+
 		itemsScore[item] = b.GetScore(float64(views[item]), float64(clicks[item]), float64(len(views)))
 	}
 
+	return itemsScore
+}
+
+// UseWeighted scores items by fractional counters, items without views are explored first.
+func (b *Bandit) UseWeighted(items []string, clicks map[string]float64, views map[string]float64) (string, error) {
+	if len(items) == 0 {
+		return "", ErrEmptySlice
+	}
+
+	itemsScore := b.GetWeightedScores(items, clicks, views)
+
 	topScore := b.GetTopScore(itemsScore)
 	itemsWithTopScore := b.GetItemsWithTopScore(itemsScore, topScore)
-	itemID := b.GetRandomItemFromTop(itemsWithTopScore)
 
-	return itemID, nil
+	return b.GetRandomItemFromTop(itemsWithTopScore), nil
+}
+
+// GetWeightedScores returns scores UseWeighted ranks items by.
+func (b *Bandit) GetWeightedScores(items []string, clicks map[string]float64, views map[string]float64) map[string]float64 {
+	totalUses := 0.0
+
+	for _, item := range items {
+		totalUses += views[item]
+	}
+
+	itemsScore := make(map[string]float64)
+
+	for _, item := range items {
+		if views[item] == 0 {
+			itemsScore[item] = math.Inf(1)
+
+			continue
+		}
+
+		itemsScore[item] = b.GetScore(views[item], clicks[item], math.Max(totalUses, 1))
+	}
+
+	return itemsScore
 }
 
 func New() *Bandit {
@@ -1,6 +1,7 @@
 package bandit
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -21,6 +22,25 @@ func TestBandit(t *testing.T) {
 		require.Less(t, score, 0.1458)
 	})
 
+	t.Run("test explain", func(t *testing.T) {
+		items := []string{"item1", "item2", "item3"}
+		clicks := map[string]int{"item1": 5, "item2": 1}
+		views := map[string]int{"item1": 50, "item2": 20}
+
+		explanations := bandit.Explain(items, clicks, views)
+		scores := bandit.GetScores(items, clicks, views)
+
+		require.Equal(t, 0.1, explanations["item1"].Exploitation)
+		require.Equal(t, bandit.GetExploration(50, 2), explanations["item1"].Exploration)
+		require.Equal(t, 20.0, explanations["item2"].Views)
+
+		for _, item := range items {
+			require.Equal(t, scores[item], explanations[item].Score)
+		}
+
+		require.True(t, math.IsInf(explanations["item3"].Exploration, 1), "not viewed item should be explored")
+	})
+
 	t.Run("test top score", func(t *testing.T) {
 		scores := map[string]float64{"item1": 0.11, "item2": 0.2, "item3": 0.2, "item4": 0.16, "item5": 0.14}
 
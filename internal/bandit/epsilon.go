@@ -0,0 +1,50 @@
+package bandit
+
+import "math/rand"
+
+type EpsilonGreedyBandit struct {
+	epsilon float64
+	rnd     *rand.Rand
+}
+
+func NewEpsilonGreedy(epsilon float64, seed int64) *EpsilonGreedyBandit {
+	return &EpsilonGreedyBandit{epsilon: epsilon, rnd: newRand(seed)}
+}
+
+func (b *EpsilonGreedyBandit) Use(items []string, clicks map[string]int, views map[string]int) (string, error) {
+	if len(items) == 0 {
+		return "", ErrEmptySlice
+	}
+
+	if b.rnd.Float64() < b.epsilon {
+		return pickRandom(b.rnd, items), nil
+	}
+
+	topRate := -1.0
+	topItems := []string{}
+
+	for _, item := range items {
+		rate := clickRate(clicks[item], views[item])
+
+		switch {
+		case rate > topRate:
+			topRate = rate
+			topItems = []string{item}
+		case rate == topRate:
+			topItems = append(topItems, item)
+		}
+	}
+
+	return pickRandom(b.rnd, topItems), nil
+}
+
+// GetScores returns click rates which are exploited with 1-epsilon probability.
+func (b *EpsilonGreedyBandit) GetScores(items []string, clicks map[string]int, views map[string]int) map[string]float64 {
+	scores := make(map[string]float64, len(items))
+
+	for _, item := range items {
+		scores[item] = clickRate(clicks[item], views[item])
+	}
+
+	return scores
+}
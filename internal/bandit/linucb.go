@@ -0,0 +1,311 @@
+package bandit
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+)
+
+type ModelStorage interface {
+	GetBanditModel(slotID string, strategy string) ([]byte, error)
+	UpdateBanditModel(slotID string, strategy string, update func(model []byte) ([]byte, error)) error
+}
+
+// LinUCBBandit is disjoint LinUCB, every item of slot has own linear model over request features.
+// Models are cached per slot and updates are applied to the stored model, so updates of other instances are kept.
+type LinUCBBandit struct {
+	// mu guards slots and rnd
+	mu       sync.Mutex
+	alpha    float64
+	features []string
+	storage  ModelStorage
+	slots    map[string]*linUCBSlot
+	rnd      *rand.Rand
+}
+
+// linUCBSlot caches model of slot, its lock keeps loading and updates of the slot in order.
+// Cached model is replaced on update and never changed, so it can be scored without lock.
+type linUCBSlot struct {
+	mu    sync.Mutex
+	model *LinUCBModel
+}
+
+type LinUCBModel struct {
+	Features []string              `json:"features"`
+	Arms     map[string]*LinUCBArm `json:"arms"`
+}
+
+type LinUCBArm struct {
+	// AInv is inverse of design matrix A = I + sum(x * x^T), kept up to date with Sherman-Morrison formula.
+	AInv [][]float64 `json:"a_inv"`
+	B    []float64   `json:"b"`
+}
+
+func NewLinUCB(alpha float64, features []string, storage ModelStorage, seed int64) *LinUCBBandit {
+	return &LinUCBBandit{
+		alpha:    alpha,
+		features: features,
+		storage:  storage,
+		slots:    make(map[string]*linUCBSlot),
+		rnd:      newRand(seed),
+	}
+}
+
+// GetVector maps request features to vector with bias term first, unknown features are ignored.
+func (b *LinUCBBandit) GetVector(features map[string]float64) []float64 {
+	vector := make([]float64, len(b.features)+1)
+	vector[0] = 1
+
+	for i, name := range b.features {
+		vector[i+1] = features[name]
+	}
+
+	return vector
+}
+
+func (b *LinUCBBandit) GetScore(arm *LinUCBArm, x []float64) float64 {
+	aInvX := mulVector(arm.AInv, x)
+	theta := mulVector(arm.AInv, arm.B)
+
+	return dot(theta, x) + b.alpha*math.Sqrt(dot(x, aInvX))
+}
+
+func (b *LinUCBBandit) Use(slotID string, items []string, features map[string]float64) (string, error) {
+	if len(items) == 0 {
+		return "", ErrEmptySlice
+	}
+
+	model, err := b.getModel(slotID)
+	if err != nil {
+		return "", err
+	}
+
+	scores := b.getScores(model, items, features)
+
+	topScore := math.Inf(-1)
+	topItems := []string{}
+
+	for _, item := range items {
+		switch {
+		case scores[item] > topScore:
+			topScore = scores[item]
+			topItems = []string{item}
+		case scores[item] == topScore:
+			topItems = append(topItems, item)
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return pickRandom(b.rnd, topItems), nil
+}
+
+// GetScores returns upper confidence bounds of items for request features.
+func (b *LinUCBBandit) GetScores(slotID string, items []string, features map[string]float64) (map[string]float64, error) {
+	model, err := b.getModel(slotID)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.getScores(model, items, features), nil
+}
+
+// getScores gives score of initial arm to items which are not in model, model is not changed.
+func (b *LinUCBBandit) getScores(model *LinUCBModel, items []string, features map[string]float64) map[string]float64 {
+	x := b.GetVector(features)
+	scores := make(map[string]float64, len(items))
+
+	for _, item := range items {
+		arm, ok := model.Arms[item]
+		if !ok {
+			arm = newLinUCBArm(len(x))
+		}
+
+		scores[item] = b.GetScore(arm, x)
+	}
+
+	return scores
+}
+
+func (b *LinUCBBandit) AddView(slotID string, item string, features map[string]float64) error {
+	return b.update(slotID, item, features, func(arm *LinUCBArm, x []float64) {
+		aInvX := mulVector(arm.AInv, x)
+		denominator := 1 + dot(x, aInvX)
+
+		for i := range arm.AInv {
+			for j := range arm.AInv[i] {
+				arm.AInv[i][j] -= aInvX[i] * aInvX[j] / denominator
+			}
+		}
+	})
+}
+
+func (b *LinUCBBandit) AddClick(slotID string, item string, features map[string]float64) error {
+	return b.update(slotID, item, features, func(arm *LinUCBArm, x []float64) {
+		for i := range arm.B {
+			arm.B[i] += x[i]
+		}
+	})
+}
+
+// Forget drops cached model of slot, it is called when slot is deleted.
+func (b *LinUCBBandit) Forget(slotID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.slots, slotID)
+}
+
+// update applies change to the stored model of slot and caches the result.
+func (b *LinUCBBandit) update(slotID string, item string, features map[string]float64, apply func(arm *LinUCBArm, x []float64)) error {
+	slot := b.getSlot(slotID)
+
+	slot.mu.Lock()
+	defer slot.mu.Unlock()
+
+	x := b.GetVector(features)
+
+	var model *LinUCBModel
+
+	err := b.storage.UpdateBanditModel(slotID, LinUCB, func(data []byte) ([]byte, error) {
+		var err error
+
+		if model, err = b.decodeModel(data); err != nil {
+			return nil, err
+		}
+
+		apply(model.arm(item, len(x)), x)
+
+		data, err = json.Marshal(model)
+		if err != nil {
+			return nil, fmt.Errorf("cannot marshal linucb model, %w", err)
+		}
+
+		return data, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	slot.model = model
+
+	return nil
+}
+
+func (b *LinUCBBandit) getSlot(slotID string) *linUCBSlot {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	slot, ok := b.slots[slotID]
+	if !ok {
+		slot = &linUCBSlot{}
+		b.slots[slotID] = slot
+	}
+
+	return slot
+}
+
+func (b *LinUCBBandit) getModel(slotID string) (*LinUCBModel, error) {
+	slot := b.getSlot(slotID)
+
+	slot.mu.Lock()
+	defer slot.mu.Unlock()
+
+	if slot.model != nil {
+		return slot.model, nil
+	}
+
+	data, err := b.storage.GetBanditModel(slotID, LinUCB)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load linucb model, %w", err)
+	}
+
+	model, err := b.decodeModel(data)
+	if err != nil {
+		return nil, err
+	}
+
+	slot.model = model
+
+	return model, nil
+}
+
+func (b *LinUCBBandit) decodeModel(data []byte) (*LinUCBModel, error) {
+	model := &LinUCBModel{}
+
+	if data != nil {
+		if err := json.Unmarshal(data, model); err != nil {
+			return nil, fmt.Errorf("cannot unmarshal linucb model, %w", err)
+		}
+	}
+
+	// model trained on other features cannot be reused
+	if !equalStrings(model.Features, b.features) {
+		model = &LinUCBModel{Features: b.features}
+	}
+
+	if model.Arms == nil {
+		model.Arms = make(map[string]*LinUCBArm)
+	}
+
+	return model, nil
+}
+
+func (m *LinUCBModel) arm(item string, size int) *LinUCBArm {
+	arm, ok := m.Arms[item]
+	if !ok {
+		arm = newLinUCBArm(size)
+		m.Arms[item] = arm
+	}
+
+	return arm
+}
+
+// newLinUCBArm returns arm without views, its A is identity.
+func newLinUCBArm(size int) *LinUCBArm {
+	arm := &LinUCBArm{AInv: make([][]float64, size), B: make([]float64, size)}
+
+	for i := range arm.AInv {
+		arm.AInv[i] = make([]float64, size)
+		arm.AInv[i][i] = 1
+	}
+
+	return arm
+}
+
+func mulVector(matrix [][]float64, vector []float64) []float64 {
+	result := make([]float64, len(matrix))
+
+	for i, row := range matrix {
+		result[i] = dot(row, vector)
+	}
+
+	return result
+}
+
+func dot(a []float64, b []float64) float64 {
+	result := 0.0
+
+	for i := range a {
+		result += a[i] * b[i]
+	}
+
+	return result
+}
+
+func equalStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
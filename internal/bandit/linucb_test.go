@@ -0,0 +1,133 @@
+package bandit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type modelStorage struct {
+	models map[string][]byte
+}
+
+func (s *modelStorage) GetBanditModel(slotID string, strategy string) ([]byte, error) {
+	return s.models[slotID+strategy], nil
+}
+
+func (s *modelStorage) UpdateBanditModel(slotID string, strategy string, update func(model []byte) ([]byte, error)) error {
+	model, err := update(s.models[slotID+strategy])
+	if err != nil {
+		return err
+	}
+
+	s.models[slotID+strategy] = model
+
+	return nil
+}
+
+func TestLinUCB(t *testing.T) {
+	items := []string{"item1", "item2"}
+	mobile := map[string]float64{"mobile": 1}
+	desktop := map[string]float64{"mobile": 0}
+
+	train := func(t *testing.T, bandit *LinUCBBandit) {
+		t.Helper()
+
+		for i := 0; i < 200; i++ {
+			for _, item := range items {
+				require.NoError(t, bandit.AddView("slot1", item, mobile))
+				require.NoError(t, bandit.AddView("slot1", item, desktop))
+			}
+
+			if i%5 == 0 {
+				require.NoError(t, bandit.AddClick("slot1", "item1", mobile))
+				require.NoError(t, bandit.AddClick("slot1", "item2", desktop))
+			}
+		}
+	}
+
+	t.Run("test vector", func(t *testing.T) {
+		bandit := NewLinUCB(1, []string{"mobile", "hour"}, &modelStorage{map[string][]byte{}}, 1)
+
+		require.Equal(t, []float64{1, 1, 0}, bandit.GetVector(map[string]float64{"mobile": 1, "unknown": 5}))
+	})
+
+	t.Run("test initial score", func(t *testing.T) {
+		bandit := NewLinUCB(2, []string{"mobile"}, &modelStorage{map[string][]byte{}}, 1)
+		arm := (&LinUCBModel{Arms: map[string]*LinUCBArm{}}).arm("item1", 2)
+
+		// theta is zero and A is identity, so score is alpha * |x|
+		require.InDelta(t, 2.8284, bandit.GetScore(arm, []float64{1, 1}), 0.0001)
+	})
+
+	t.Run("test context changes choice", func(t *testing.T) {
+		bandit := NewLinUCB(0.1, []string{"mobile"}, &modelStorage{map[string][]byte{}}, 1)
+		train(t, bandit)
+
+		item, err := bandit.Use("slot1", items, mobile)
+		require.NoError(t, err)
+		require.Equal(t, "item1", item)
+
+		item, err = bandit.Use("slot1", items, desktop)
+		require.NoError(t, err)
+		require.Equal(t, "item2", item)
+	})
+
+	t.Run("test model is restored from storage", func(t *testing.T) {
+		storage := &modelStorage{map[string][]byte{}}
+		train(t, NewLinUCB(0.1, []string{"mobile"}, storage, 1))
+
+		bandit := NewLinUCB(0.1, []string{"mobile"}, storage, 1)
+
+		item, err := bandit.Use("slot1", items, mobile)
+		require.NoError(t, err)
+		require.Equal(t, "item1", item)
+	})
+
+	t.Run("test model with other features is reset", func(t *testing.T) {
+		storage := &modelStorage{map[string][]byte{}}
+		train(t, NewLinUCB(0.1, []string{"mobile"}, storage, 1))
+
+		bandit := NewLinUCB(0.1, []string{"hour", "mobile"}, storage, 1)
+		model, err := bandit.getModel("slot1")
+
+		require.NoError(t, err)
+		require.Empty(t, model.Arms)
+	})
+
+	t.Run("test updates of other instances are kept", func(t *testing.T) {
+		storage := &modelStorage{map[string][]byte{}}
+		first := NewLinUCB(0.1, []string{"mobile"}, storage, 1)
+		second := NewLinUCB(0.1, []string{"mobile"}, storage, 1)
+
+		require.NoError(t, first.AddView("slot1", "item1", mobile))
+		require.NoError(t, second.AddView("slot1", "item2", mobile))
+		require.NoError(t, first.AddClick("slot1", "item1", mobile))
+
+		model, err := NewLinUCB(0.1, []string{"mobile"}, storage, 1).getModel("slot1")
+		require.NoError(t, err)
+		require.Len(t, model.Arms, 2)
+		require.Equal(t, []float64{1, 1}, model.Arms["item1"].B)
+	})
+
+	t.Run("test forget", func(t *testing.T) {
+		storage := &modelStorage{map[string][]byte{}}
+		bandit := NewLinUCB(0.1, []string{"mobile"}, storage, 1)
+		train(t, bandit)
+
+		// stored model is removed with deleted slot
+		storage.models = map[string][]byte{}
+		bandit.Forget("slot1")
+
+		model, err := bandit.getModel("slot1")
+		require.NoError(t, err)
+		require.Empty(t, model.Arms)
+	})
+
+	t.Run("test empty slice", func(t *testing.T) {
+		item, err := NewLinUCB(1, nil, &modelStorage{map[string][]byte{}}, 1).Use("slot1", []string{}, nil)
+
+		require.ErrorIs(t, err, ErrEmptySlice)
+		require.Empty(t, item)
+	})
+}
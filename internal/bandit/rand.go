@@ -0,0 +1,49 @@
+package bandit
+
+import (
+	"math/rand"
+	"sync"
+)
+
+// lockedSource makes a seeded rand.Source safe for the concurrent GetBanner calls.
+type lockedSource struct {
+	mu  sync.Mutex
+	src rand.Source64
+}
+
+func newRand(seed int64) *rand.Rand {
+	return rand.New(&lockedSource{src: rand.NewSource(seed).(rand.Source64)})
+}
+
+func (s *lockedSource) Int63() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.src.Int63()
+}
+
+func (s *lockedSource) Uint64() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.src.Uint64()
+}
+
+func (s *lockedSource) Seed(seed int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.src.Seed(seed)
+}
+
+func clickRate(clicks int, views int) float64 {
+	if views == 0 {
+		return 0
+	}
+
+	return float64(clicks) / float64(views)
+}
+
+func pickRandom(rnd *rand.Rand, items []string) string {
+	return items[rnd.Intn(len(items))]
+}
@@ -0,0 +1,30 @@
+package bandit
+
+import "math/rand"
+
+type RandomBandit struct {
+	rnd *rand.Rand
+}
+
+func NewRandom(seed int64) *RandomBandit {
+	return &RandomBandit{rnd: newRand(seed)}
+}
+
+func (b *RandomBandit) Use(items []string, clicks map[string]int, views map[string]int) (string, error) {
+	if len(items) == 0 {
+		return "", ErrEmptySlice
+	}
+
+	return pickRandom(b.rnd, items), nil
+}
+
+// GetScores returns equal probabilities of items to be selected.
+func (b *RandomBandit) GetScores(items []string, clicks map[string]int, views map[string]int) map[string]float64 {
+	scores := make(map[string]float64, len(items))
+
+	for _, item := range items {
+		scores[item] = 1 / float64(len(items))
+	}
+
+	return scores
+}
@@ -0,0 +1,227 @@
+package bandit
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	UCB1          = "ucb1"
+	EpsilonGreedy = "epsilon-greedy"
+	Thompson      = "thompson"
+	Softmax       = "softmax"
+	Random        = "random"
+	LinUCB        = "linucb"
+	SlidingWindow = "sliding-window-ucb"
+	Discounted    = "discounted-ucb"
+)
+
+var (
+	ErrUnknownStrategy = errors.New("unknown bandit strategy")
+	ErrNoScores        = errors.New("bandit strategy does not report scores")
+)
+
+type Strategy interface {
+	Use(items []string, clicks map[string]int, views map[string]int) (string, error)
+}
+
+// Scorer reports scores strategy ranks items by, randomized strategies report expected values or probabilities.
+type Scorer interface {
+	GetScores(items []string, clicks map[string]int, views map[string]int) map[string]float64
+}
+
+// ContextualStrategy learns from request features instead of click and view counters.
+type ContextualStrategy interface {
+	Use(slotID string, items []string, features map[string]float64) (string, error)
+	AddView(slotID string, item string, features map[string]float64) error
+	AddClick(slotID string, item string, features map[string]float64) error
+	GetScores(slotID string, items []string, features map[string]float64) (map[string]float64, error)
+	// Forget drops state of deleted slot which strategy keeps in memory.
+	Forget(slotID string)
+}
+
+// TimeAwareStrategy scores items by hourly statistics so recent events can outweigh old ones.
+type TimeAwareStrategy interface {
+	// Since returns the oldest moment which statistics are needed from.
+	Since(now time.Time) time.Time
+	Use(items []string, buckets []TimeBucket, now time.Time) (string, error)
+	GetScores(items []string, buckets []TimeBucket, now time.Time) map[string]float64
+}
+
+// Explainer reports parts of scores strategy ranks items by.
+type Explainer interface {
+	Explain(items []string, clicks map[string]int, views map[string]int) map[string]Explanation
+}
+
+// TimeAwareExplainer reports parts of scores time aware strategy ranks items by.
+type TimeAwareExplainer interface {
+	Explain(items []string, buckets []TimeBucket, now time.Time) map[string]Explanation
+}
+
+type TimeBucket struct {
+	Item   string
+	Time   time.Time
+	Views  int
+	Clicks int
+}
+
+type Registry struct {
+	strategies           map[string]Strategy
+	contextualStrategies map[string]ContextualStrategy
+	timeAwareStrategies  map[string]TimeAwareStrategy
+	defaultStrategy      string
+}
+
+func NewRegistry(defaultStrategy string) *Registry {
+	return &Registry{
+		strategies:           make(map[string]Strategy),
+		contextualStrategies: make(map[string]ContextualStrategy),
+		timeAwareStrategies:  make(map[string]TimeAwareStrategy),
+		defaultStrategy:      defaultStrategy,
+	}
+}
+
+func (r *Registry) Register(name string, strategy Strategy) {
+	r.strategies[name] = strategy
+}
+
+func (r *Registry) RegisterContextual(name string, strategy ContextualStrategy) {
+	r.contextualStrategies[name] = strategy
+}
+
+func (r *Registry) GetContextual(name string) (ContextualStrategy, bool) {
+	if name == "" {
+		name = r.defaultStrategy
+	}
+
+	strategy, ok := r.contextualStrategies[name]
+
+	return strategy, ok
+}
+
+// ForgetSlot drops state of deleted slot in all contextual strategies,
+// slot strategy could be changed so every one is called.
+func (r *Registry) ForgetSlot(slotID string) {
+	for _, strategy := range r.contextualStrategies {
+		strategy.Forget(slotID)
+	}
+}
+
+func (r *Registry) RegisterTimeAware(name string, strategy TimeAwareStrategy) {
+	r.timeAwareStrategies[name] = strategy
+}
+
+func (r *Registry) GetTimeAware(name string) (TimeAwareStrategy, bool) {
+	if name == "" {
+		name = r.defaultStrategy
+	}
+
+	strategy, ok := r.timeAwareStrategies[name]
+
+	return strategy, ok
+}
+
+// Resolve returns name of default strategy for empty name.
+func (r *Registry) Resolve(name string) string {
+	if name == "" {
+		return r.defaultStrategy
+	}
+
+	return name
+}
+
+func (r *Registry) Get(name string) (Strategy, error) {
+	name = r.Resolve(name)
+
+	strategy, ok := r.strategies[name]
+	if !ok {
+		return nil, fmt.Errorf("%q, %w", name, ErrUnknownStrategy)
+	}
+
+	return strategy, nil
+}
+
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.strategies)+len(r.contextualStrategies)+len(r.timeAwareStrategies))
+
+	for name := range r.strategies {
+		names = append(names, name)
+	}
+
+	for name := range r.contextualStrategies {
+		names = append(names, name)
+	}
+
+	for name := range r.timeAwareStrategies {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func (r *Registry) Validate(name string) error {
+	if _, ok := r.GetContextual(name); ok {
+		return nil
+	}
+
+	if _, ok := r.GetTimeAware(name); ok {
+		return nil
+	}
+
+	_, err := r.Get(name)
+
+	return err
+}
+
+func (r *Registry) Use(name string, items []string, clicks map[string]int, views map[string]int) (string, error) {
+	strategy, err := r.Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	return strategy.Use(items, clicks, views)
+}
+
+func (r *Registry) Scores(name string, items []string, clicks map[string]int, views map[string]int) (map[string]float64, error) {
+	strategy, err := r.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	scorer, ok := strategy.(Scorer)
+	if !ok {
+		return nil, fmt.Errorf("%q, %w", name, ErrNoScores)
+	}
+
+	return scorer.GetScores(items, clicks, views), nil
+}
+
+// Explain returns parts of scores of strategy, strategies which are not explainers
+// report only their scores and counters.
+func (r *Registry) Explain(name string, items []string, clicks map[string]int, views map[string]int) (map[string]Explanation, error) {
+	strategy, err := r.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if explainer, ok := strategy.(Explainer); ok {
+		return explainer.Explain(items, clicks, views), nil
+	}
+
+	scores, err := r.Scores(name, items, clicks, views)
+	if err != nil {
+		return nil, err
+	}
+
+	explanations := make(map[string]Explanation, len(items))
+
+	for _, item := range items {
+		explanations[item] = Explanation{Views: float64(views[item]), Clicks: float64(clicks[item]), Score: scores[item]}
+	}
+
+	return explanations, nil
+}
@@ -0,0 +1,160 @@
+package bandit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry(UCB1)
+	registry.Register(UCB1, New())
+	registry.Register(Random, NewRandom(1))
+
+	t.Run("test default strategy", func(t *testing.T) {
+		strategy, err := registry.Get("")
+
+		require.NoError(t, err)
+		require.IsType(t, &Bandit{}, strategy)
+	})
+
+	t.Run("test named strategy", func(t *testing.T) {
+		strategy, err := registry.Get(Random)
+
+		require.NoError(t, err)
+		require.IsType(t, &RandomBandit{}, strategy)
+	})
+
+	t.Run("test unknown strategy", func(t *testing.T) {
+		item, err := registry.Use("unknown", []string{"item1"}, map[string]int{}, map[string]int{"item1": 1})
+
+		require.ErrorIs(t, err, ErrUnknownStrategy)
+		require.Empty(t, item)
+		require.ErrorIs(t, registry.Validate("unknown"), ErrUnknownStrategy)
+	})
+
+	t.Run("test contextual strategy", func(t *testing.T) {
+		registry.RegisterContextual(LinUCB, NewLinUCB(1, nil, &modelStorage{map[string][]byte{}}, 1))
+
+		_, ok := registry.GetContextual(LinUCB)
+		require.True(t, ok)
+		require.NoError(t, registry.Validate(LinUCB))
+
+		_, ok = registry.GetContextual("")
+		require.False(t, ok, "default strategy is not contextual")
+	})
+
+	t.Run("test scores", func(t *testing.T) {
+		scores, err := registry.Scores(UCB1, []string{"item1", "item2"}, map[string]int{"item1": 1}, map[string]int{"item1": 10})
+
+		require.NoError(t, err)
+		require.InDelta(t, New().GetScore(10, 1, 1), scores["item1"], 1e-9)
+		require.True(t, math.IsInf(scores["item2"], 1), "not viewed item should be explored first")
+
+		scores, err = registry.Scores(Random, []string{"item1", "item2"}, nil, nil)
+
+		require.NoError(t, err)
+		require.Equal(t, map[string]float64{"item1": 0.5, "item2": 0.5}, scores)
+
+		_, err = registry.Scores("unknown", nil, nil, nil)
+		require.ErrorIs(t, err, ErrUnknownStrategy)
+	})
+
+	t.Run("test names", func(t *testing.T) {
+		require.Equal(t, []string{LinUCB, Random, UCB1}, registry.Names())
+	})
+}
+
+func TestStrategies(t *testing.T) {
+	items := []string{"item1", "item2", "item3", "item4", "item5"}
+
+	t.Run("test epsilon greedy exploits best item", func(t *testing.T) {
+		bandit := NewEpsilonGreedy(0.1, 1)
+		clicks := map[string]int{"item3": 50}
+		views := map[string]int{"item1": 1000, "item2": 1000, "item3": 1000, "item4": 1000, "item5": 1000}
+
+		results := map[string]int{}
+
+		for i := 0; i < 10000; i++ {
+			item, err := bandit.Use(items, clicks, views)
+			require.NoError(t, err)
+
+			results[item]++
+		}
+
+		require.Greater(t, results["item3"], 9000, "best item should be chosen except for exploration")
+		require.Greater(t, results["item1"], 0, "other items should be explored")
+	})
+
+	t.Run("test epsilon greedy full exploration", func(t *testing.T) {
+		bandit := NewEpsilonGreedy(1, 1)
+		clicks := map[string]int{"item3": 50}
+		views := map[string]int{"item1": 1000, "item2": 1000, "item3": 1000, "item4": 1000, "item5": 1000}
+
+		results := map[string]int{}
+
+		for i := 0; i < 10000; i++ {
+			item, _ := bandit.Use(items, clicks, views)
+			results[item]++
+		}
+
+		for _, value := range results {
+			require.Greater(t, value, 1800)
+			require.Less(t, value, 2200)
+		}
+	})
+
+	t.Run("test softmax weights", func(t *testing.T) {
+		bandit := NewSoftmax(0.1, 1)
+		clicks := map[string]int{"item1": 10, "item2": 20}
+		views := map[string]int{"item1": 100, "item2": 100}
+
+		weights := bandit.GetWeights([]string{"item1", "item2"}, clicks, views)
+
+		require.Len(t, weights, 2)
+		require.Equal(t, 1.0, weights[1], "best item weight should be normalized to 1")
+		require.InDelta(t, 0.3679, weights[0], 0.0001)
+	})
+
+	t.Run("test softmax prefers clicked item", func(t *testing.T) {
+		bandit := NewSoftmax(0.01, 1)
+		clicks := map[string]int{"item3": 100}
+		views := map[string]int{"item1": 1000, "item2": 1000, "item3": 1000, "item4": 1000, "item5": 1000}
+
+		results := map[string]int{}
+
+		for i := 0; i < 1000; i++ {
+			item, _ := bandit.Use(items, clicks, views)
+			results[item]++
+		}
+
+		for key, value := range results {
+			if key == "item3" {
+				continue
+			}
+
+			require.Less(t, value, results["item3"], "total count should be less than item3 value")
+		}
+	})
+
+	t.Run("test seeded strategies are deterministic", func(t *testing.T) {
+		first, second := NewRandom(42), NewRandom(42)
+
+		for i := 0; i < 100; i++ {
+			item1, _ := first.Use(items, nil, nil)
+			item2, _ := second.Use(items, nil, nil)
+
+			require.Equal(t, item1, item2)
+		}
+	})
+
+	t.Run("test empty slice", func(t *testing.T) {
+		for _, strategy := range []Strategy{NewEpsilonGreedy(0.1, 1), NewSoftmax(0.1, 1), NewRandom(1)} {
+			item, err := strategy.Use([]string{}, map[string]int{}, map[string]int{})
+
+			require.ErrorIs(t, err, ErrEmptySlice)
+			require.Empty(t, item)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package bandit
+
+import (
+	"math"
+	"math/rand"
+)
+
+type SoftmaxBandit struct {
+	temperature float64
+	rnd         *rand.Rand
+}
+
+func NewSoftmax(temperature float64, seed int64) *SoftmaxBandit {
+	return &SoftmaxBandit{temperature: temperature, rnd: newRand(seed)}
+}
+
+func (b *SoftmaxBandit) GetWeights(items []string, clicks map[string]int, views map[string]int) []float64 {
+	rates := make([]float64, len(items))
+	maxRate := 0.0
+
+	for i, item := range items {
+		rates[i] = clickRate(clicks[item], views[item])
+		maxRate = math.Max(maxRate, rates[i])
+	}
+
+	weights := make([]float64, len(items))
+
+	for i, rate := range rates {
+		// shifting by the max rate keeps exp() from overflowing on low temperatures
+		weights[i] = math.Exp((rate - maxRate) / b.temperature)
+	}
+
+	return weights
+}
+
+func (b *SoftmaxBandit) Use(items []string, clicks map[string]int, views map[string]int) (string, error) {
+	if len(items) == 0 {
+		return "", ErrEmptySlice
+	}
+
+	weights := b.GetWeights(items, clicks, views)
+	total := 0.0
+
+	for _, weight := range weights {
+		total += weight
+	}
+
+	point := b.rnd.Float64() * total
+
+	for i, weight := range weights {
+		point -= weight
+		if point < 0 {
+			return items[i], nil
+		}
+	}
+
+	return items[len(items)-1], nil
+}
+
+// GetScores returns probabilities of items to be selected.
+func (b *SoftmaxBandit) GetScores(items []string, clicks map[string]int, views map[string]int) map[string]float64 {
+	weights := b.GetWeights(items, clicks, views)
+	total := 0.0
+
+	for _, weight := range weights {
+		total += weight
+	}
+
+	scores := make(map[string]float64, len(items))
+
+	for i, item := range items {
+		scores[item] = weights[i] / total
+	}
+
+	return scores
+}
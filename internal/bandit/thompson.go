@@ -0,0 +1,90 @@
+package bandit
+
+import (
+	"math"
+	"math/rand"
+)
+
+type ThompsonBandit struct {
+	alpha float64
+	beta  float64
+	rnd   *rand.Rand
+}
+
+// NewThompson creates Beta-Bernoulli Thompson sampling with Beta(alpha, beta) prior for every item.
+func NewThompson(alpha float64, beta float64, seed int64) *ThompsonBandit {
+	return &ThompsonBandit{alpha: alpha, beta: beta, rnd: newRand(seed)}
+}
+
+func (b *ThompsonBandit) GetSample(viewsCount int, clicksCount int) float64 {
+	failures := viewsCount - clicksCount
+	if failures < 0 {
+		failures = 0
+	}
+
+	return b.sampleBeta(float64(clicksCount)+b.alpha, float64(failures)+b.beta)
+}
+
+func (b *ThompsonBandit) Use(items []string, clicks map[string]int, views map[string]int) (string, error) {
+	if len(items) == 0 {
+		return "", ErrEmptySlice
+	}
+
+	topSample := -1.0
+	topItem := ""
+
+	for _, item := range items {
+		sample := b.GetSample(views[item], clicks[item])
+		if sample > topSample {
+			topSample = sample
+			topItem = item
+		}
+	}
+
+	return topItem, nil
+}
+
+// GetScores returns posterior mean click rates which samples are drawn around.
+func (b *ThompsonBandit) GetScores(items []string, clicks map[string]int, views map[string]int) map[string]float64 {
+	scores := make(map[string]float64, len(items))
+
+	for _, item := range items {
+		failures := math.Max(float64(views[item]-clicks[item]), 0)
+		scores[item] = (float64(clicks[item]) + b.alpha) / (float64(clicks[item]) + failures + b.alpha + b.beta)
+	}
+
+	return scores
+}
+
+func (b *ThompsonBandit) sampleBeta(alpha float64, beta float64) float64 {
+	x := b.sampleGamma(alpha)
+	y := b.sampleGamma(beta)
+
+	return x / (x + y)
+}
+
+// sampleGamma draws from Gamma(shape, 1) using Marsaglia and Tsang method.
+func (b *ThompsonBandit) sampleGamma(shape float64) float64 {
+	if shape < 1 {
+		return b.sampleGamma(shape+1) * math.Pow(b.rnd.Float64(), 1/shape)
+	}
+
+	d := shape - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+
+	for {
+		x := b.rnd.NormFloat64()
+		v := 1 + c*x
+
+		if v <= 0 {
+			continue
+		}
+
+		v = v * v * v
+		u := b.rnd.Float64()
+
+		if math.Log(u) < 0.5*x*x+d-d*v+d*math.Log(v) {
+			return d * v
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package bandit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestThompson(t *testing.T) {
+	t.Run("test sample mean", func(t *testing.T) {
+		bandit := NewThompson(1, 1, 1)
+
+		sum := 0.0
+
+		for i := 0; i < 10000; i++ {
+			sample := bandit.GetSample(100, 30)
+
+			require.GreaterOrEqual(t, sample, 0.0)
+			require.LessOrEqual(t, sample, 1.0)
+
+			sum += sample
+		}
+
+		// mean of Beta(31, 71)
+		require.InDelta(t, 31.0/102.0, sum/10000, 0.005)
+	})
+
+	t.Run("test prior without data", func(t *testing.T) {
+		bandit := NewThompson(2, 8, 1)
+
+		sum := 0.0
+
+		for i := 0; i < 10000; i++ {
+			sum += bandit.GetSample(0, 0)
+		}
+
+		require.InDelta(t, 0.2, sum/10000, 0.01)
+	})
+
+	t.Run("test clicked results rate", func(t *testing.T) {
+		bandit := NewThompson(1, 1, 1)
+		items := []string{"item1", "item2", "item3", "item4", "item5"}
+		clicks := map[string]int{"item3": 20, "item1": 5}
+		views := map[string]int{"item1": 1000, "item2": 1000, "item3": 1000, "item4": 1000, "item5": 1000}
+
+		results := map[string]int{}
+
+		for i := 0; i < 1000; i++ {
+			item, err := bandit.Use(items, clicks, views)
+			require.NoError(t, err)
+
+			results[item]++
+		}
+
+		require.Greater(t, results["item3"], 900, "best item should win most of draws")
+	})
+
+	t.Run("test new items are explored", func(t *testing.T) {
+		bandit := NewThompson(1, 1, 1)
+		items := []string{"item1", "item2"}
+		clicks := map[string]int{"item1": 10}
+		views := map[string]int{"item1": 1000}
+
+		results := map[string]int{}
+
+		for i := 0; i < 1000; i++ {
+			item, _ := bandit.Use(items, clicks, views)
+			results[item]++
+		}
+
+		require.Greater(t, results["item2"], 0, "item without views should be sampled from prior")
+	})
+
+	t.Run("test same seed gives same choices", func(t *testing.T) {
+		first, second := NewThompson(1, 1, 7), NewThompson(1, 1, 7)
+		items := []string{"item1", "item2", "item3"}
+		views := map[string]int{"item1": 10, "item2": 10, "item3": 10}
+
+		for i := 0; i < 100; i++ {
+			item1, _ := first.Use(items, map[string]int{}, views)
+			item2, _ := second.Use(items, map[string]int{}, views)
+
+			require.Equal(t, item1, item2)
+		}
+	})
+
+	t.Run("test empty slice", func(t *testing.T) {
+		item, err := NewThompson(1, 1, 1).Use([]string{}, map[string]int{}, map[string]int{})
+
+		require.ErrorIs(t, err, ErrEmptySlice)
+		require.Empty(t, item)
+	})
+}
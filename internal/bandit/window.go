@@ -0,0 +1,128 @@
+package bandit
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// SlidingWindowBandit is UCB1 over events of the last window duration and/or the last events count,
+// statistics are hourly so limits are applied with an hour precision.
+type SlidingWindowBandit struct {
+	ucb    *Bandit
+	window time.Duration
+	events int
+}
+
+// DiscountedBandit is UCB1 over counters decayed exponentially with half life.
+type DiscountedBandit struct {
+	ucb      *Bandit
+	halfLife time.Duration
+}
+
+// discountedHorizon is amount of half lives after which events weight is below 0.1% and they are skipped.
+const discountedHorizon = 10
+
+func NewSlidingWindow(window time.Duration, events int) *SlidingWindowBandit {
+	return &SlidingWindowBandit{ucb: New(), window: window, events: events}
+}
+
+func (b *SlidingWindowBandit) Since(now time.Time) time.Time {
+	if b.window == 0 {
+		return time.Time{}
+	}
+
+	return now.Add(-b.window)
+}
+
+func (b *SlidingWindowBandit) Use(items []string, buckets []TimeBucket, now time.Time) (string, error) {
+	clicks, views := b.counters(buckets, now)
+
+	return b.ucb.UseWeighted(items, clicks, views)
+}
+
+func (b *SlidingWindowBandit) GetScores(items []string, buckets []TimeBucket, now time.Time) map[string]float64 {
+	clicks, views := b.counters(buckets, now)
+
+	return b.ucb.GetWeightedScores(items, clicks, views)
+}
+
+func (b *SlidingWindowBandit) Explain(items []string, buckets []TimeBucket, now time.Time) map[string]Explanation {
+	clicks, views := b.counters(buckets, now)
+
+	return b.ucb.ExplainWeighted(items, clicks, views)
+}
+
+func (b *SlidingWindowBandit) counters(buckets []TimeBucket, now time.Time) (clicks map[string]float64, views map[string]float64) {
+	since := b.Since(now)
+	sorted := make([]TimeBucket, len(buckets))
+	copy(sorted, buckets)
+
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Time.After(sorted[j].Time) })
+
+	clicks = make(map[string]float64)
+	views = make(map[string]float64)
+	totalViews := 0
+
+	for _, bucket := range sorted {
+		if bucket.Time.Before(since.Truncate(time.Hour)) || (b.events > 0 && totalViews >= b.events) {
+			break
+		}
+
+		clicks[bucket.Item] += float64(bucket.Clicks)
+		views[bucket.Item] += float64(bucket.Views)
+		totalViews += bucket.Views
+	}
+
+	return clicks, views
+}
+
+func NewDiscounted(halfLife time.Duration) *DiscountedBandit {
+	return &DiscountedBandit{ucb: New(), halfLife: halfLife}
+}
+
+func (b *DiscountedBandit) Since(now time.Time) time.Time {
+	return now.Add(-discountedHorizon * b.halfLife)
+}
+
+func (b *DiscountedBandit) GetWeight(bucketTime time.Time, now time.Time) float64 {
+	// events are spread over the hour, so its middle is taken as their age
+	age := now.Sub(bucketTime.Add(time.Hour / 2))
+	if age < 0 {
+		age = 0
+	}
+
+	return math.Pow(0.5, float64(age)/float64(b.halfLife))
+}
+
+func (b *DiscountedBandit) Use(items []string, buckets []TimeBucket, now time.Time) (string, error) {
+	clicks, views := b.counters(buckets, now)
+
+	return b.ucb.UseWeighted(items, clicks, views)
+}
+
+func (b *DiscountedBandit) GetScores(items []string, buckets []TimeBucket, now time.Time) map[string]float64 {
+	clicks, views := b.counters(buckets, now)
+
+	return b.ucb.GetWeightedScores(items, clicks, views)
+}
+
+func (b *DiscountedBandit) Explain(items []string, buckets []TimeBucket, now time.Time) map[string]Explanation {
+	clicks, views := b.counters(buckets, now)
+
+	return b.ucb.ExplainWeighted(items, clicks, views)
+}
+
+func (b *DiscountedBandit) counters(buckets []TimeBucket, now time.Time) (clicks map[string]float64, views map[string]float64) {
+	clicks = make(map[string]float64)
+	views = make(map[string]float64)
+
+	for _, bucket := range buckets {
+		weight := b.GetWeight(bucket.Time, now)
+
+		clicks[bucket.Item] += weight * float64(bucket.Clicks)
+		views[bucket.Item] += weight * float64(bucket.Views)
+	}
+
+	return clicks, views
+}
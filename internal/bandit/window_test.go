@@ -0,0 +1,77 @@
+package bandit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeAware(t *testing.T) {
+	now := time.Date(2021, 8, 1, 12, 30, 0, 0, time.UTC)
+	items := []string{"item1", "item2"}
+
+	// item1 was good a week ago, item2 is good now
+	buckets := []TimeBucket{
+		{Item: "item1", Time: now.Add(-7 * 24 * time.Hour).Truncate(time.Hour), Views: 1000, Clicks: 300},
+		{Item: "item2", Time: now.Add(-7 * 24 * time.Hour).Truncate(time.Hour), Views: 1000, Clicks: 10},
+		{Item: "item1", Time: now.Add(-time.Hour).Truncate(time.Hour), Views: 1000, Clicks: 10},
+		{Item: "item2", Time: now.Add(-time.Hour).Truncate(time.Hour), Views: 1000, Clicks: 100},
+	}
+
+	t.Run("test plain ucb prefers old winner", func(t *testing.T) {
+		item, err := NewSlidingWindow(0, 0).Use(items, buckets, now)
+
+		require.NoError(t, err)
+		require.Equal(t, "item1", item)
+	})
+
+	t.Run("test sliding window by duration", func(t *testing.T) {
+		bandit := NewSlidingWindow(24*time.Hour, 0)
+
+		require.Equal(t, now.Add(-24*time.Hour), bandit.Since(now))
+
+		item, err := bandit.Use(items, buckets, now)
+
+		require.NoError(t, err)
+		require.Equal(t, "item2", item)
+	})
+
+	t.Run("test sliding window by events", func(t *testing.T) {
+		item, err := NewSlidingWindow(0, 2000).Use(items, buckets, now)
+
+		require.NoError(t, err)
+		require.Equal(t, "item2", item)
+	})
+
+	t.Run("test sliding window explores items out of window", func(t *testing.T) {
+		recent := []TimeBucket{{Item: "item2", Time: now.Truncate(time.Hour), Views: 10, Clicks: 5}}
+
+		item, err := NewSlidingWindow(time.Hour, 0).Use(items, recent, now)
+
+		require.NoError(t, err)
+		require.Equal(t, "item1", item)
+	})
+
+	t.Run("test discounted weight", func(t *testing.T) {
+		bandit := NewDiscounted(time.Hour)
+
+		require.Equal(t, 1.0, bandit.GetWeight(now.Truncate(time.Hour), now))
+		require.InDelta(t, 0.5, bandit.GetWeight(now.Add(-time.Hour).Truncate(time.Hour), now), 0.0001)
+		require.Equal(t, now.Add(-10*time.Hour), bandit.Since(now))
+	})
+
+	t.Run("test discounted prefers recent winner", func(t *testing.T) {
+		item, err := NewDiscounted(24*time.Hour).Use(items, buckets, now)
+
+		require.NoError(t, err)
+		require.Equal(t, "item2", item)
+	})
+
+	t.Run("test empty slice", func(t *testing.T) {
+		item, err := NewDiscounted(time.Hour).Use([]string{}, buckets, now)
+
+		require.ErrorIs(t, err, ErrEmptySlice)
+		require.Empty(t, item)
+	})
+}
@@ -1,16 +1,22 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	Logger LoggerConf `json:"logger"`
-	DB     DBConf     `json:"db"`
-	HTTP   HTTPConf   `json:"http"`
-	AMPQ   AMPQConf   `json:"ampq"`
+	Logger  LoggerConf  `json:"logger"`
+	Storage StorageConf `json:"storage"`
+	DB      DBConf      `json:"db"`
+	HTTP    HTTPConf    `json:"http"`
+	AMPQ    AMPQConf    `json:"ampq"`
+	Bandit  BanditConf  `json:"bandit"`
+	// Impressions configures tokens which link clicks to served impressions.
+	Impressions ImpressionsConf `json:"impressions"`
 }
 
 type LoggerConf struct {
@@ -18,32 +24,207 @@ type LoggerConf struct {
 	File  string `json:"file"`
 }
 
+type StorageConf struct {
+	// Type is "sql" for postgres or "memory" for storage without persistence.
+	Type string `json:"type"`
+}
+
 type DBConf struct {
 	ConnectionString string `json:"connection_string"`
+	// AutoMigrate applies pending migrations on service start.
+	AutoMigrate bool `json:"auto_migrate"`
 }
 
 type HTTPConf struct {
 	Host     string `json:"host"`
 	Port     string `json:"port"`
 	GrpcPort string `json:"grpc_port"`
+	// WriteTimeout limits response writing, stats export should fit in it.
+	WriteTimeout time.Duration `json:"write_timeout"`
 }
 
 type AMPQConf struct {
 	URI  string `json:"uri"`
 	Name string `json:"name"`
+	// Prefetch limits unacknowledged messages of consumer.
+	Prefetch int `json:"prefetch"`
+	// RetryLimit is attempts of consumer to add event to storage before message is rejected,
+	// attempts are delayed by reconnection backoff.
+	RetryLimit int `json:"retry_limit"`
+	// ReconnectMin and ReconnectMax bound exponential backoff of producer reconnection.
+	ReconnectMin time.Duration `json:"reconnect_min"`
+	ReconnectMax time.Duration `json:"reconnect_max"`
+	// Confirm enables publisher confirms, publishing waits for them up to ConfirmTimeout.
+	Confirm        bool          `json:"confirm"`
+	ConfirmTimeout time.Duration `json:"confirm_timeout"`
+	// OutboxInterval, OutboxBatch and OutboxClaim configure relay of events from outbox to queue.
+	OutboxInterval time.Duration `json:"outbox_interval"`
+	OutboxBatch    int           `json:"outbox_batch"`
+	OutboxClaim    time.Duration `json:"outbox_claim"`
+}
+
+type ImpressionsConf struct {
+	// Key is HMAC key of tokens, empty key disables them. It is overridden by ImpressionsKeyEnv.
+	Key string        `json:"key"`
+	TTL time.Duration `json:"ttl"`
+	// Required rejects clicks without token.
+	Required bool `json:"required"`
+}
+
+type BanditConf struct {
+	Strategy           string            `json:"strategy"`
+	Epsilon            float64           `json:"epsilon"`
+	Temperature        float64           `json:"temperature"`
+	PriorAlpha         float64           `json:"prior_alpha"`
+	PriorBeta          float64           `json:"prior_beta"`
+	Slots              map[string]string `json:"slots"`
+	SocialDemoMinViews int               `json:"social_demo_min_views"`
+	LinUCBAlpha        float64           `json:"linucb_alpha"`
+	Features           []string          `json:"features"`
+	SlidingWindow      time.Duration     `json:"sliding_window"`
+	SlidingEvents      int               `json:"sliding_events"`
+	HalfLife           time.Duration     `json:"half_life"`
 }
 
+const (
+	// ImpressionsKeyEnv is environment variable of impressions key, so key isn't kept in config file.
+	ImpressionsKeyEnv = "BANNERS_ROTATION_IMPRESSIONS_KEY"
+
+	// impressionsKeyPlaceholder is key of config examples which should be replaced.
+	impressionsKeyPlaceholder = "change-me"
+	impressionsKeyMinLength   = 32
+)
+
 func New(configFile string) (Config, error) {
 	viper.SetConfigFile(configFile)
+	viper.SetDefault("storage.type", "sql")
+	viper.SetDefault("http.write_timeout", "10s")
+	viper.SetDefault("ampq.prefetch", 10)
+	viper.SetDefault("ampq.retry_limit", 5)
+	viper.SetDefault("ampq.reconnect_min", "1s")
+	viper.SetDefault("ampq.reconnect_max", "30s")
+	viper.SetDefault("ampq.confirm_timeout", "5s")
+	viper.SetDefault("ampq.outbox_interval", "1s")
+	viper.SetDefault("ampq.outbox_batch", 100)
+	viper.SetDefault("ampq.outbox_claim", "1m")
+	viper.SetDefault("bandit.strategy", "ucb1")
+	viper.SetDefault("bandit.epsilon", 0.1)
+	viper.SetDefault("bandit.temperature", 0.1)
+	viper.SetDefault("bandit.prior_alpha", 1)
+	viper.SetDefault("bandit.prior_beta", 1)
+	viper.SetDefault("bandit.linucb_alpha", 1)
+	viper.SetDefault("bandit.sliding_window", "24h")
+	viper.SetDefault("bandit.half_life", "24h")
+	viper.SetDefault("impressions.ttl", "24h")
+
+	if err := viper.BindEnv("impressions.key", ImpressionsKeyEnv); err != nil {
+		return Config{}, fmt.Errorf("cannot bind impressions key env, %w", err)
+	}
 
 	if err := viper.ReadInConfig(); err != nil { // Handle errors reading the config file
 		return Config{}, fmt.Errorf("fatal error config file: %w", err)
 	}
 
-	return Config{
+	configuration := Config{
 		LoggerConf{Level: viper.GetString("logger.level"), File: viper.GetString("logger.file")},
-		DBConf{ConnectionString: viper.GetString("db.connection_string")},
-		HTTPConf{Host: viper.GetString("http.host"), Port: viper.GetString("http.port"), GrpcPort: viper.GetString("http.grpc_port")},
-		AMPQConf{URI: viper.GetString("ampq.uri"), Name: viper.GetString("ampq.name")},
-	}, nil
+		StorageConf{Type: viper.GetString("storage.type")},
+		DBConf{ConnectionString: viper.GetString("db.connection_string"), AutoMigrate: viper.GetBool("db.auto_migrate")},
+		HTTPConf{
+			Host:         viper.GetString("http.host"),
+			Port:         viper.GetString("http.port"),
+			GrpcPort:     viper.GetString("http.grpc_port"),
+			WriteTimeout: viper.GetDuration("http.write_timeout"),
+		},
+		AMPQConf{
+			URI:            viper.GetString("ampq.uri"),
+			Name:           viper.GetString("ampq.name"),
+			Prefetch:       viper.GetInt("ampq.prefetch"),
+			RetryLimit:     viper.GetInt("ampq.retry_limit"),
+			ReconnectMin:   viper.GetDuration("ampq.reconnect_min"),
+			ReconnectMax:   viper.GetDuration("ampq.reconnect_max"),
+			Confirm:        viper.GetBool("ampq.confirm"),
+			ConfirmTimeout: viper.GetDuration("ampq.confirm_timeout"),
+			OutboxInterval: viper.GetDuration("ampq.outbox_interval"),
+			OutboxBatch:    viper.GetInt("ampq.outbox_batch"),
+			OutboxClaim:    viper.GetDuration("ampq.outbox_claim"),
+		},
+		BanditConf{
+			Strategy:           viper.GetString("bandit.strategy"),
+			Epsilon:            viper.GetFloat64("bandit.epsilon"),
+			Temperature:        viper.GetFloat64("bandit.temperature"),
+			PriorAlpha:         viper.GetFloat64("bandit.prior_alpha"),
+			PriorBeta:          viper.GetFloat64("bandit.prior_beta"),
+			Slots:              viper.GetStringMapString("bandit.slots"),
+			SocialDemoMinViews: viper.GetInt("bandit.social_demo_min_views"),
+			LinUCBAlpha:        viper.GetFloat64("bandit.linucb_alpha"),
+			Features:           viper.GetStringSlice("bandit.features"),
+			SlidingWindow:      viper.GetDuration("bandit.sliding_window"),
+			SlidingEvents:      viper.GetInt("bandit.sliding_events"),
+			HalfLife:           viper.GetDuration("bandit.half_life"),
+		},
+		ImpressionsConf{
+			Key:      viper.GetString("impressions.key"),
+			TTL:      viper.GetDuration("impressions.ttl"),
+			Required: viper.GetBool("impressions.required"),
+		},
+	}
+
+	if err := configuration.Bandit.Validate(); err != nil {
+		return Config{}, err
+	}
+
+	if err := configuration.Impressions.Validate(); err != nil {
+		return Config{}, err
+	}
+
+	return configuration, nil
+}
+
+var ErrInvalidConfig = errors.New("invalid config")
+
+// Validate checks parameters of strategies, values out of range give NaN scores or empty statistics.
+// Zero sliding window is allowed with sliding events, then window is limited by events count only.
+func (c BanditConf) Validate() error {
+	if c.Epsilon < 0 || c.Epsilon > 1 {
+		return fmt.Errorf("bandit.epsilon should be in [0, 1], %w", ErrInvalidConfig)
+	}
+
+	if c.Temperature <= 0 {
+		return fmt.Errorf("bandit.temperature should be positive, %w", ErrInvalidConfig)
+	}
+
+	if c.PriorAlpha <= 0 || c.PriorBeta <= 0 {
+		return fmt.Errorf("bandit.prior_alpha and bandit.prior_beta should be positive, %w", ErrInvalidConfig)
+	}
+
+	if c.SlidingWindow < 0 || (c.SlidingWindow == 0 && c.SlidingEvents <= 0) {
+		return fmt.Errorf("bandit.sliding_window should be positive or zero with positive sliding_events, %w", ErrInvalidConfig)
+	}
+
+	if c.HalfLife <= 0 {
+		return fmt.Errorf("bandit.half_life should be positive, %w", ErrInvalidConfig)
+	}
+
+	if c.SlidingEvents < 0 {
+		return fmt.Errorf("bandit.sliding_events should not be negative, %w", ErrInvalidConfig)
+	}
+
+	return nil
+}
+
+// Validate refuses placeholder and short impressions keys, tokens signed by them can be forged.
+func (c ImpressionsConf) Validate() error {
+	if c.Key == "" {
+		return nil
+	}
+
+	if c.Key == impressionsKeyPlaceholder {
+		return fmt.Errorf("impressions.key is a placeholder, set %s, %w", ImpressionsKeyEnv, ErrInvalidConfig)
+	}
+
+	if len(c.Key) < impressionsKeyMinLength {
+		return fmt.Errorf("impressions.key should be at least %d bytes, %w", impressionsKeyMinLength, ErrInvalidConfig)
+	}
+
+	return nil
 }
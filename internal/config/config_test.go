@@ -0,0 +1,66 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBanditConf(t *testing.T) {
+	valid := BanditConf{Epsilon: 0.1, Temperature: 0.1, PriorAlpha: 1, PriorBeta: 1, SlidingWindow: 24 * time.Hour, HalfLife: 24 * time.Hour}
+
+	t.Run("test epsilon and temperature", func(t *testing.T) {
+		for _, epsilon := range []float64{0, 1} {
+			bounds := valid
+			bounds.Epsilon = epsilon
+			require.NoError(t, bounds.Validate())
+		}
+
+		for _, epsilon := range []float64{-0.1, 1.1} {
+			outOfRange := valid
+			outOfRange.Epsilon = epsilon
+			require.ErrorIs(t, outOfRange.Validate(), ErrInvalidConfig)
+		}
+
+		zero := valid
+		zero.Temperature = 0
+		require.ErrorIs(t, zero.Validate(), ErrInvalidConfig, "zero temperature gives NaN weights")
+	})
+
+	t.Run("test priors", func(t *testing.T) {
+		zeroAlpha := valid
+		zeroAlpha.PriorAlpha = 0
+		require.ErrorIs(t, zeroAlpha.Validate(), ErrInvalidConfig, "zero prior gives NaN samples")
+
+		negativeBeta := valid
+		negativeBeta.PriorBeta = -1
+		require.ErrorIs(t, negativeBeta.Validate(), ErrInvalidConfig)
+	})
+
+	t.Run("test sliding window", func(t *testing.T) {
+		require.NoError(t, valid.Validate())
+
+		eventsOnly := valid
+		eventsOnly.SlidingWindow, eventsOnly.SlidingEvents = 0, 100
+		require.NoError(t, eventsOnly.Validate(), "zero window should be allowed with events limit")
+
+		noLimits := valid
+		noLimits.SlidingWindow = 0
+		require.ErrorIs(t, noLimits.Validate(), ErrInvalidConfig)
+
+		negative := valid
+		negative.SlidingWindow, negative.SlidingEvents = -time.Hour, 100
+		require.ErrorIs(t, negative.Validate(), ErrInvalidConfig)
+
+		negativeEvents := valid
+		negativeEvents.SlidingEvents = -1
+		require.ErrorIs(t, negativeEvents.Validate(), ErrInvalidConfig)
+	})
+
+	t.Run("test half life", func(t *testing.T) {
+		zero := valid
+		zero.HalfLife = 0
+		require.ErrorIs(t, zero.Validate(), ErrInvalidConfig)
+	})
+}
@@ -0,0 +1,117 @@
+package impression
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid impression token")
+	ErrExpiredToken = errors.New("impression token is expired")
+)
+
+// Kind tells whether view of impression is recorded on selection or later by pixel.
+type Kind string
+
+const (
+	// KindServed is impression of selection which recorded its view.
+	KindServed Kind = "served"
+	// KindPreview is impression of dry run selection, its view is recorded by pixel when banner is shown.
+	KindPreview Kind = "preview"
+)
+
+// Impression is a served banner which token is given for, ID is unique for every impression.
+type Impression struct {
+	ID           string `json:"id"`
+	Kind         Kind   `json:"kind"`
+	SlotID       string `json:"slot_id"`
+	BannerID     string `json:"banner_id"`
+	SocialDemoID string `json:"social_demo_id"`
+	// Features are request features of selection, contextual strategies learn from its view and click with them.
+	Features  map[string]float64 `json:"features,omitempty"`
+	ExpiresAt int64              `json:"expires_at"`
+}
+
+// Signer issues and validates tokens which are base64 encoded impression with its HMAC-SHA256 signature.
+type Signer struct {
+	key []byte
+	ttl time.Duration
+}
+
+func NewSigner(key []byte, ttl time.Duration) *Signer {
+	return &Signer{key: key, ttl: ttl}
+}
+
+// Sign returns token of new impression of kind which expires after ttl.
+func (s *Signer) Sign(
+	kind Kind,
+	slotID string,
+	bannerID string,
+	socialDemoID string,
+	features map[string]float64,
+	now time.Time,
+) (string, error) {
+	payload, err := json.Marshal(Impression{
+		ID:           uuid.NewString(),
+		Kind:         kind,
+		SlotID:       slotID,
+		BannerID:     bannerID,
+		SocialDemoID: socialDemoID,
+		Features:     features,
+		ExpiresAt:    now.Add(s.ttl).Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("cannot encode impression, %w", err)
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.sign(encoded)), nil
+}
+
+// Parse checks signature and expiration of token and returns its impression,
+// impression of expired token is returned with ErrExpiredToken.
+func (s *Signer) Parse(token string, now time.Time) (Impression, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return Impression{}, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(signature, s.sign(parts[0])) {
+		return Impression{}, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return Impression{}, ErrInvalidToken
+	}
+
+	var impression Impression
+
+	if err := json.Unmarshal(payload, &impression); err != nil || impression.ID == "" ||
+		(impression.Kind != KindServed && impression.Kind != KindPreview) {
+		return Impression{}, ErrInvalidToken
+	}
+
+	if now.Unix() > impression.ExpiresAt {
+		return impression, ErrExpiredToken
+	}
+
+	return impression, nil
+}
+
+func (s *Signer) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(payload))
+
+	return mac.Sum(nil)
+}
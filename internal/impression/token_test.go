@@ -0,0 +1,76 @@
+package impression
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner(t *testing.T) {
+	signer := NewSigner([]byte("secret"), time.Hour)
+	now := time.Date(2021, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("test sign and parse", func(t *testing.T) {
+		token, err := signer.Sign(KindServed, "slot1", "banner1", "social_demo1", nil, now)
+		require.NoError(t, err)
+
+		impression, err := signer.Parse(token, now.Add(time.Hour))
+		require.NoError(t, err)
+		require.NotEmpty(t, impression.ID)
+		require.Equal(t, "slot1", impression.SlotID)
+		require.Equal(t, "banner1", impression.BannerID)
+		require.Equal(t, "social_demo1", impression.SocialDemoID)
+		require.Equal(t, KindServed, impression.Kind)
+		require.Nil(t, impression.Features)
+
+		other, err := signer.Sign(KindServed, "slot1", "banner1", "social_demo1", nil, now)
+		require.NoError(t, err)
+		require.NotEqual(t, token, other, "every impression should have unique token")
+	})
+
+	t.Run("test features", func(t *testing.T) {
+		token, err := signer.Sign(KindServed, "slot1", "banner1", "social_demo1", map[string]float64{"mobile": 1}, now)
+		require.NoError(t, err)
+
+		impression, err := signer.Parse(token, now)
+		require.NoError(t, err)
+		require.Equal(t, map[string]float64{"mobile": 1}, impression.Features)
+	})
+
+	t.Run("test expired token", func(t *testing.T) {
+		token, err := signer.Sign(KindServed, "slot1", "banner1", "social_demo1", nil, now)
+		require.NoError(t, err)
+
+		impression, err := signer.Parse(token, now.Add(time.Hour+time.Second))
+		require.ErrorIs(t, err, ErrExpiredToken)
+		require.Equal(t, "banner1", impression.BannerID, "impression of expired token should be returned")
+	})
+
+	t.Run("test invalid token", func(t *testing.T) {
+		token, err := signer.Sign(KindServed, "slot1", "banner1", "social_demo1", nil, now)
+		require.NoError(t, err)
+
+		_, err = NewSigner([]byte("other"), time.Hour).Parse(token, now)
+		require.ErrorIs(t, err, ErrInvalidToken, "token of other key should be rejected")
+
+		other, err := signer.Sign(KindServed, "slot1", "banner2", "social_demo1", nil, now)
+		require.NoError(t, err)
+
+		forged := strings.Split(other, ".")[0] + "." + strings.Split(token, ".")[1]
+		_, err = signer.Parse(forged, now)
+		require.ErrorIs(t, err, ErrInvalidToken, "payload with signature of other token should be rejected")
+
+		unknown, err := signer.Sign(Kind("unknown"), "slot1", "banner1", "social_demo1", nil, now)
+		require.NoError(t, err)
+
+		_, err = signer.Parse(unknown, now)
+		require.ErrorIs(t, err, ErrInvalidToken, "token of unknown kind should be rejected")
+
+		for _, value := range []string{"", "token", "a.b.c", "!.!"} {
+			_, err = signer.Parse(value, now)
+			require.ErrorIs(t, err, ErrInvalidToken)
+		}
+	})
+}
@@ -0,0 +1,267 @@
+package internalgrpc
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Fuchsoria/banners-rotation/internal/app"
+	sqlstorage "github.com/Fuchsoria/banners-rotation/internal/storage/sql"
+)
+
+const (
+	ExportPath = "/api/v1/admin/stats/export"
+
+	exportCSV   = "csv"
+	exportJSONL = "jsonl"
+
+	exportAggregated = "aggregated"
+	exportRaw        = "raw"
+
+	// exportFlushRows is a count of rows after which written part of export is sent to client.
+	exportFlushRows = 1000
+)
+
+var ErrInvalidExport = errors.New("invalid export request")
+
+type connContextKey struct{}
+
+// withConn keeps connection of request in its context, so handler can change its deadlines.
+func withConn(ctx context.Context, conn net.Conn) context.Context {
+	return context.WithValue(ctx, connContextKey{}, conn)
+}
+
+// clearWriteDeadline removes write deadline which server sets by write timeout before handler is called,
+// so long export isn't cut off. Connection is closed by client or by shutdown of server.
+func clearWriteDeadline(r *http.Request) error {
+	conn, ok := r.Context().Value(connContextKey{}).(net.Conn)
+	if !ok {
+		return nil
+	}
+
+	if err := conn.SetWriteDeadline(time.Time{}); err != nil {
+		return fmt.Errorf("cannot clear write deadline, %w", err)
+	}
+
+	return nil
+}
+
+var (
+	statsExportHeader  = []string{"slot_id", "banner_id", "social_demo_id", "time", "views", "clicks", "ctr"}
+	eventsExportHeader = []string{"type", "slot_id", "banner_id", "social_demo_id", "date"}
+)
+
+type statsExportRow struct {
+	SlotID       string  `json:"slot_id"`
+	BannerID     string  `json:"banner_id"`
+	SocialDemoID string  `json:"social_demo_id"`
+	Time         string  `json:"time"`
+	Views        int     `json:"views"`
+	Clicks       int     `json:"clicks"`
+	CTR          float64 `json:"ctr"`
+}
+
+type eventExportRow struct {
+	Type         string `json:"type"`
+	SlotID       string `json:"slot_id"`
+	BannerID     string `json:"banner_id"`
+	SocialDemoID string `json:"social_demo_id"`
+	Date         string `json:"date"`
+}
+
+// exportWriter writes export rows as csv or json lines, headers are sent with the first row,
+// so errors which happen before it can still be returned with error status.
+type exportWriter struct {
+	w       http.ResponseWriter
+	format  string
+	header  []string
+	csv     *csv.Writer
+	json    *json.Encoder
+	rows    int
+	started bool
+}
+
+func newExportWriter(w http.ResponseWriter, format string, header []string) *exportWriter {
+	return &exportWriter{w: w, format: format, header: header, csv: csv.NewWriter(w), json: json.NewEncoder(w)}
+}
+
+func (e *exportWriter) start() error {
+	if e.started {
+		return nil
+	}
+
+	e.started = true
+
+	if e.format == exportCSV {
+		e.w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	} else {
+		e.w.Header().Set("Content-Type", "application/x-ndjson")
+	}
+
+	e.w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="stats.%s"`, e.format))
+	e.w.WriteHeader(http.StatusOK)
+
+	if e.format == exportCSV {
+		return e.csv.Write(e.header)
+	}
+
+	return nil
+}
+
+func (e *exportWriter) write(record []string, value interface{}) error {
+	if err := e.start(); err != nil {
+		return err
+	}
+
+	if e.format == exportCSV {
+		if err := e.csv.Write(record); err != nil {
+			return err
+		}
+	} else if err := e.json.Encode(value); err != nil {
+		return err
+	}
+
+	e.rows++
+	if e.rows%exportFlushRows == 0 {
+		return e.flush()
+	}
+
+	return nil
+}
+
+func (e *exportWriter) flush() error {
+	if e.format == exportCSV {
+		e.csv.Flush()
+
+		if err := e.csv.Error(); err != nil {
+			return err
+		}
+	}
+
+	if flusher, ok := e.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+
+	return nil
+}
+
+// exportHandler streams aggregated stats or raw events as csv or json lines,
+// query has format, mode and GetStats fields, group_by can be repeated or comma separated.
+func exportHandler(application app.App) func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		format, mode, query, err := parseExportRequest(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		if err := clearWriteDeadline(r); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+
+		var writer *exportWriter
+
+		if mode == exportRaw {
+			writer = newExportWriter(w, format, eventsExportHeader)
+			err = application.ExportEvents(query, func(row sqlstorage.EventRow) error {
+				date := row.Date.UTC().Format(time.RFC3339Nano)
+
+				return writer.write(
+					[]string{row.Type, row.SlotID, row.BannerID, row.SocialDemoID, date},
+					eventExportRow{row.Type, row.SlotID, row.BannerID, row.SocialDemoID, date},
+				)
+			})
+		} else {
+			writer = newExportWriter(w, format, statsExportHeader)
+			err = application.ExportStats(query, func(row app.StatsRow) error {
+				bucket := ""
+				if row.Time != nil {
+					bucket = row.Time.UTC().Format(time.RFC3339)
+				}
+
+				return writer.write(
+					[]string{
+						row.SlotID, row.BannerID, row.SocialDemoID, bucket,
+						strconv.Itoa(row.Views), strconv.Itoa(row.Clicks), strconv.FormatFloat(row.CTR, 'f', -1, 64),
+					},
+					statsExportRow{row.SlotID, row.BannerID, row.SocialDemoID, bucket, row.Views, row.Clicks, row.CTR},
+				)
+			})
+		}
+
+		if err == nil {
+			err = writer.start()
+		}
+
+		if err == nil {
+			err = writer.flush()
+		}
+
+		if err != nil {
+			application.GetLogger().Error(fmt.Errorf("cannot export stats, %w", err).Error())
+
+			if !writer.started {
+				http.Error(w, "cannot export stats", http.StatusInternalServerError)
+			}
+		}
+	}
+}
+
+func parseExportRequest(r *http.Request) (format string, mode string, query sqlstorage.StatsQuery, err error) {
+	values := r.URL.Query()
+
+	format = values.Get("format")
+	if format == "" {
+		format = exportCSV
+	}
+
+	if format != exportCSV && format != exportJSONL {
+		return "", "", query, fmt.Errorf("unknown format %q, %w", format, ErrInvalidExport)
+	}
+
+	mode = values.Get("mode")
+	if mode == "" {
+		mode = exportAggregated
+	}
+
+	if mode != exportAggregated && mode != exportRaw {
+		return "", "", query, fmt.Errorf("unknown mode %q, %w", mode, ErrInvalidExport)
+	}
+
+	for _, value := range values["group_by"] {
+		for _, field := range strings.Split(value, ",") {
+			if field != "" {
+				query.GroupBy = append(query.GroupBy, field)
+			}
+		}
+	}
+
+	query.Bucket = values.Get("bucket")
+	query.SlotID = values.Get("slot_id")
+	query.BannerID = values.Get("banner_id")
+	query.SocialDemoID = values.Get("social_demo_id")
+
+	if query.From, err = parseTime(values.Get("from")); err != nil {
+		return "", "", query, fmt.Errorf("invalid from, %w", err)
+	}
+
+	if query.To, err = parseTime(values.Get("to")); err != nil {
+		return "", "", query, fmt.Errorf("invalid to, %w", err)
+	}
+
+	if err := query.Validate(); err != nil {
+		return "", "", query, err
+	}
+
+	return format, mode, query, nil
+}
@@ -0,0 +1,25 @@
+package internalgrpc
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/Fuchsoria/banners-rotation/internal/app"
+)
+
+const HealthPath = "/health"
+
+// healthHandler responds with state of dependencies, it is 200 while amqp is reconnecting too
+// because selections and events don't depend on broker, so status is degraded instead.
+func healthHandler(application app.App) func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		health := application.Health()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+
+		if err := json.NewEncoder(w).Encode(health); err != nil {
+			application.GetLogger().Error("cannot write health, " + err.Error())
+		}
+	}
+}
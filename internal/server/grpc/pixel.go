@@ -0,0 +1,72 @@
+package internalgrpc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Fuchsoria/banners-rotation/internal/app"
+	"github.com/Fuchsoria/banners-rotation/internal/storage"
+)
+
+const PixelPath = "/pixel.gif"
+
+// pixel is transparent 1x1 gif.
+var pixel = []byte{
+	0x47, 0x49, 0x46, 0x38, 0x39, 0x61, 0x01, 0x00, 0x01, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xff, 0x21, 0xf9, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x2c, 0x00, 0x00, 0x00, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x00, 0x02, 0x02, 0x44, 0x01, 0x00, 0x3b,
+}
+
+// pixelHandler records view by preview impression token or by slot_id, banner_id and social_demo_id
+// query parameters and always responds with transparent pixel, so broken hits are not visible on page.
+func pixelHandler(application app.App) func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		header := w.Header()
+		header.Set("Content-Type", "image/gif")
+		header.Set("Content-Length", strconv.Itoa(len(pixel)))
+		header.Set("Cache-Control", "no-cache, no-store, must-revalidate, private, max-age=0")
+		header.Set("Pragma", "no-cache")
+		header.Set("Expires", "0")
+
+		w.WriteHeader(pixelStatus(application, recordPixelView(application, r)))
+
+		if r.Method != http.MethodHead {
+			_, _ = w.Write(pixel)
+		}
+	}
+}
+
+func recordPixelView(application app.App, r *http.Request) error {
+	query := r.URL.Query()
+
+	if token := query.Get("token"); token != "" {
+		return application.AddImpressionViewEvent(token)
+	}
+
+	slotID, bannerID, socialDemoID := query.Get("slot_id"), query.Get("banner_id"), query.Get("social_demo_id")
+	if slotID == "" || bannerID == "" || socialDemoID == "" {
+		return ErrBadRequest
+	}
+
+	return application.RecordImpression(bannerID, slotID, socialDemoID, nil)
+}
+
+func pixelStatus(application app.App, err error) int {
+	switch {
+	case err == nil:
+		return http.StatusOK
+	case errors.Is(err, storage.ErrAlreadyExists) || errors.Is(err, app.ErrServedImpression):
+		// repeated hit of the same impression is counted once and view of served impression is counted on selection
+		return http.StatusOK
+	case errors.Is(err, ErrBadRequest) || app.IsImpressionError(err):
+		return http.StatusBadRequest
+	case errors.Is(err, storage.ErrNotFound):
+		return http.StatusNotFound
+	default:
+		application.GetLogger().Error(fmt.Errorf("cannot record pixel view, %w", err).Error())
+
+		return http.StatusInternalServerError
+	}
+}
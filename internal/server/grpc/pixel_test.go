@@ -0,0 +1,98 @@
+package internalgrpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Fuchsoria/banners-rotation/internal/app"
+	"github.com/Fuchsoria/banners-rotation/internal/bandit"
+	"github.com/Fuchsoria/banners-rotation/internal/impression"
+	memorystorage "github.com/Fuchsoria/banners-rotation/internal/storage/memory"
+	sqlstorage "github.com/Fuchsoria/banners-rotation/internal/storage/sql"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testLogger struct{}
+
+func (l testLogger) Info(msg string, keysAndValues ...interface{})  {}
+func (l testLogger) Warn(msg string, keysAndValues ...interface{})  {}
+func (l testLogger) Debug(msg string, keysAndValues ...interface{}) {}
+func (l testLogger) Error(msg string, keysAndValues ...interface{}) {}
+func (l testLogger) GetInstance() *zap.Logger                       { return zap.NewNop() }
+
+func TestPixelHandler(t *testing.T) {
+	testStorage := memorystorage.New()
+
+	_, err := testStorage.CreateSlot("slot1", "", "")
+	require.NoError(t, err)
+	_, err = testStorage.CreateBanner("banner1", "", "")
+	require.NoError(t, err)
+	_, err = testStorage.CreateSocialDemo("social_demo1", "")
+	require.NoError(t, err)
+	require.NoError(t, testStorage.AddBannerRotation("banner1", "slot1"))
+
+	registry := bandit.NewRegistry(bandit.UCB1)
+	registry.Register(bandit.UCB1, bandit.New())
+
+	application := app.New(testLogger{}, testStorage, registry, nil, app.Settings{ImpressionKey: "secret"})
+	handler := pixelHandler(*application)
+
+	hit := func(method string, target string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		handler(recorder, httptest.NewRequest(method, target, nil), nil)
+
+		require.Equal(t, "image/gif", recorder.Header().Get("Content-Type"))
+
+		return recorder
+	}
+
+	views := func() int {
+		rows, err := application.GetStats(sqlstorage.StatsQuery{})
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+
+		return rows[0].Views
+	}
+
+	t.Run("test token", func(t *testing.T) {
+		tokens, err := application.ImpressionTokens(impression.KindPreview, "slot1", "social_demo1", []string{"banner1"}, nil)
+		require.NoError(t, err)
+
+		recorder := hit(http.MethodGet, PixelPath+"?token="+tokens[0])
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.Equal(t, pixel, recorder.Body.Bytes())
+		require.Equal(t, 1, views())
+
+		recorder = hit(http.MethodGet, PixelPath+"?token="+tokens[0])
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.Equal(t, 1, views(), "view of the same token should be recorded once")
+	})
+
+	t.Run("test query params", func(t *testing.T) {
+		before := views()
+
+		recorder := hit(http.MethodGet, PixelPath+"?slot_id=slot1&banner_id=banner1&social_demo_id=social_demo1")
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.Equal(t, pixel, recorder.Body.Bytes())
+		require.Equal(t, before+1, views())
+
+		recorder = hit(http.MethodGet, PixelPath+"?slot_id=slot1&banner_id=banner2&social_demo_id=social_demo1")
+		require.Equal(t, http.StatusNotFound, recorder.Code)
+		require.Equal(t, pixel, recorder.Body.Bytes(), "pixel should be returned on errors")
+
+		recorder = hit(http.MethodGet, PixelPath+"?slot_id=slot1&banner_id=banner1")
+		require.Equal(t, http.StatusBadRequest, recorder.Code)
+		require.Equal(t, before+1, views())
+	})
+
+	t.Run("test head", func(t *testing.T) {
+		before := views()
+
+		recorder := hit(http.MethodHead, PixelPath+"?slot_id=slot1&banner_id=banner1&social_demo_id=social_demo1")
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.Empty(t, recorder.Body.Bytes())
+		require.Equal(t, before+1, views())
+	})
+}
@@ -0,0 +1,39 @@
+package internalgrpc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Fuchsoria/banners-rotation/internal/app"
+	"github.com/Fuchsoria/banners-rotation/internal/storage"
+)
+
+const ClickPath = "/c/{token}"
+
+// clickHandler records click of impression token and redirects to target url of its banner.
+func clickHandler(application app.App) func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		w.Header().Set("Cache-Control", "no-store")
+
+		targetURL, err := application.ClickImpression(pathParams["token"])
+		if err != nil {
+			code := http.StatusInternalServerError
+
+			switch {
+			case app.IsImpressionError(err):
+				code = http.StatusBadRequest
+			case errors.Is(err, storage.ErrNotFound):
+				code = http.StatusNotFound
+			default:
+				application.GetLogger().Error(fmt.Errorf("cannot click banner, %w", err).Error())
+			}
+
+			http.Error(w, http.StatusText(code), code)
+
+			return
+		}
+
+		http.Redirect(w, r, targetURL, http.StatusFound)
+	}
+}
@@ -9,7 +9,11 @@ import (
 	"time"
 
 	"github.com/Fuchsoria/banners-rotation/internal/app"
+	"github.com/Fuchsoria/banners-rotation/internal/bandit"
+	"github.com/Fuchsoria/banners-rotation/internal/impression"
 	gw "github.com/Fuchsoria/banners-rotation/internal/server/pb/api"
+	"github.com/Fuchsoria/banners-rotation/internal/storage"
+	sqlstorage "github.com/Fuchsoria/banners-rotation/internal/storage/sql"
 	"github.com/google/uuid"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
@@ -31,7 +35,7 @@ type grpcserver struct {
 
 var ErrBadRequest = errors.New("bad request")
 
-func NewServer(app *app.App, address string, port string, grpcPort string) (*Server, error) {
+func NewServer(app *app.App, address string, port string, grpcPort string, writeTimeout time.Duration) (*Server, error) {
 	grpcServerEndpoint := net.JoinHostPort(address, grpcPort)
 
 	lis, err := net.Listen("tcp", grpcServerEndpoint)
@@ -78,12 +82,31 @@ func NewServer(app *app.App, address string, port string, grpcPort string) (*Ser
 		return nil, fmt.Errorf("cannot register app handler, %w", err)
 	}
 
+	if err := gwmux.HandlePath(http.MethodGet, ExportPath, exportHandler(*app)); err != nil {
+		return nil, fmt.Errorf("cannot register export handler, %w", err)
+	}
+
+	if err := gwmux.HandlePath(http.MethodGet, ClickPath, clickHandler(*app)); err != nil {
+		return nil, fmt.Errorf("cannot register click handler, %w", err)
+	}
+
+	for _, method := range []string{http.MethodGet, http.MethodHead} {
+		if err := gwmux.HandlePath(method, PixelPath, pixelHandler(*app)); err != nil {
+			return nil, fmt.Errorf("cannot register pixel handler, %w", err)
+		}
+	}
+
+	if err := gwmux.HandlePath(http.MethodGet, HealthPath, healthHandler(*app)); err != nil {
+		return nil, fmt.Errorf("cannot register health handler, %w", err)
+	}
+
 	// Start HTTP server (and proxy calls to gRPC server endpoint)
 	server := &http.Server{
 		Addr:         net.JoinHostPort(address, port),
 		Handler:      gwmux,
 		ReadTimeout:  10 * time.Second,
-		WriteTimeout: 10 * time.Second,
+		WriteTimeout: writeTimeout,
+		ConnContext:  withConn,
 	}
 
 	return &Server{*app, server}, nil
@@ -110,6 +133,18 @@ func (s *Server) Stop(ctx context.Context) error {
 	return nil
 }
 
+// errorCode maps storage errors to grpc codes, fallback is used for other errors.
+func errorCode(err error, fallback codes.Code) codes.Code {
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, storage.ErrAlreadyExists):
+		return codes.AlreadyExists
+	default:
+		return fallback
+	}
+}
+
 func (s *grpcserver) AddBanner(ctx context.Context, in *gw.AddBannerRequest) (*gw.MessageResponse, error) {
 	if in.BannerId == "" || in.SlotId == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "cannot add banner in rotation, %s", ErrBadRequest)
@@ -117,7 +152,7 @@ func (s *grpcserver) AddBanner(ctx context.Context, in *gw.AddBannerRequest) (*g
 
 	err := s.app.AddBannerRotation(in.BannerId, in.SlotId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot add banner in rotation, %s", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot add banner in rotation, %s", err)
 	}
 
 	return &gw.MessageResponse{Message: "added"}, nil
@@ -137,29 +172,139 @@ func (s *grpcserver) RemoveBanner(ctx context.Context, in *gw.RemoveBannerReques
 }
 
 func (s *grpcserver) ClickEvent(ctx context.Context, in *gw.ClickEventRequest) (*gw.MessageResponse, error) {
-	if in.BannerId == "" || in.SlotId == "" || in.SocialDemoId == "" {
+	var err error
+
+	switch {
+	case in.ImpressionToken != "":
+		err = s.app.AddImpressionClickEvent(in.ImpressionToken, in.BannerId, in.SlotId, in.SocialDemoId, in.Features)
+	case in.BannerId == "" || in.SlotId == "" || in.SocialDemoId == "":
 		return nil, status.Errorf(codes.InvalidArgument, "cannot click on banner, %s", ErrBadRequest)
+	default:
+		err = s.app.AddClickEvent(in.BannerId, in.SlotId, in.SocialDemoId, in.Features)
 	}
 
-	err := s.app.AddClickEvent(in.BannerId, in.SlotId, in.SocialDemoId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot add click event, %s", err)
+		return nil, status.Errorf(clickErrorCode(err), "cannot add click event, %s", err)
 	}
 
 	return &gw.MessageResponse{Message: "clicked"}, nil
 }
 
-func (s *grpcserver) GetBanner(ctx context.Context, in *gw.GetBannerRequest) (*gw.BannerResponse, error) {
+func clickErrorCode(err error) codes.Code {
+	if app.IsImpressionError(err) {
+		return codes.InvalidArgument
+	}
+
+	return errorCode(err, codes.Internal)
+}
+
+func (s *grpcserver) RecordImpression(ctx context.Context, in *gw.ImpressionRequest) (*gw.ImpressionResponse, error) {
+	if in.BannerId == "" || in.SlotId == "" || in.SocialDemoId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot record impression, %s", ErrBadRequest)
+	}
+
+	err := s.app.RecordImpression(in.BannerId, in.SlotId, in.SocialDemoId, in.Features)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot record impression, %s", err)
+	}
+
+	tokens, err := s.app.ImpressionTokens(impression.KindServed, in.SlotId, in.SocialDemoId, []string{in.BannerId}, in.Features)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot record impression, %s", err)
+	}
+
+	return &gw.ImpressionResponse{Message: "recorded", ImpressionToken: tokens[0]}, nil
+}
+
+func (s *grpcserver) GetBanner(ctx context.Context, in *gw.GetBannerRequest) (*gw.ServedBannerResponse, error) {
 	if in.SlotId == "" || in.SocialDemoId == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "cannot get banner, %s", ErrBadRequest)
 	}
 
-	ID, err := s.app.GetBanner(in.SlotId, in.SocialDemoId)
+	getBanner, kind := s.app.GetBanner, impression.KindServed
+	if in.DryRun {
+		getBanner, kind = s.app.PreviewBanner, impression.KindPreview
+	}
+
+	ID, err := getBanner(in.SlotId, in.SocialDemoId, in.Features)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "cannot get banners, %s", err)
 	}
 
-	return &gw.BannerResponse{Id: ID}, nil
+	tokens, err := s.app.ImpressionTokens(kind, in.SlotId, in.SocialDemoId, []string{ID}, in.Features)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get banner, %s", err)
+	}
+
+	return &gw.ServedBannerResponse{Id: ID, ImpressionToken: tokens[0]}, nil
+}
+
+func (s *grpcserver) GetBanners(ctx context.Context, in *gw.GetBannersRequest) (*gw.BannersResponse, error) {
+	if in.SlotId == "" || in.SocialDemoId == "" || in.Count < 1 || in.Count > app.MaxBannersCount {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot get banners, %s", ErrBadRequest)
+	}
+
+	getBanners, kind := s.app.GetBanners, impression.KindServed
+	if in.DryRun {
+		getBanners, kind = s.app.PreviewBanners, impression.KindPreview
+	}
+
+	IDs, err := getBanners(in.SlotId, in.SocialDemoId, int(in.Count), in.Features)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot get banners, %s", err)
+	}
+
+	tokens, err := s.app.ImpressionTokens(kind, in.SlotId, in.SocialDemoId, IDs, in.Features)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get banners, %s", err)
+	}
+
+	return &gw.BannersResponse{Ids: IDs, ImpressionTokens: tokens}, nil
+}
+
+func (s *grpcserver) GetBannersBatch(ctx context.Context, in *gw.GetBannersBatchRequest) (*gw.BannersBatchResponse, error) {
+	if len(in.Slots) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot get banners batch, %s", ErrBadRequest)
+	}
+
+	requests := make([]app.SlotBannerRequest, 0, len(in.Slots))
+
+	for _, slot := range in.Slots {
+		if slot.SlotId == "" || slot.SocialDemoId == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot get banners batch, %s", ErrBadRequest)
+		}
+
+		requests = append(requests, app.SlotBannerRequest{SlotID: slot.SlotId, SocialDemoID: slot.SocialDemoId, Features: slot.Features})
+	}
+
+	getBannersBatch, kind := s.app.GetBannersBatch, impression.KindServed
+	if in.DryRun {
+		getBannersBatch, kind = s.app.PreviewBannersBatch, impression.KindPreview
+	}
+
+	banners, err := getBannersBatch(requests, in.Unique)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot get banners batch, %s", err)
+	}
+
+	response := &gw.BannersBatchResponse{Banners: make([]*gw.SlotBannerResponse, 0, len(banners))}
+
+	for i, banner := range banners {
+		slotBanner := &gw.SlotBannerResponse{SlotId: banner.SlotID, BannerId: banner.BannerID}
+
+		if banner.BannerID != "" {
+			tokens, err := s.app.ImpressionTokens(kind, banner.SlotID, requests[i].SocialDemoID, []string{banner.BannerID}, requests[i].Features)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "cannot get banners batch, %s", err)
+			}
+
+			slotBanner.ImpressionToken = tokens[0]
+		}
+
+		response.Banners = append(response.Banners, slotBanner)
+	}
+
+	return response, nil
 }
 
 func (s *grpcserver) CreateBanner(ctx context.Context, in *gw.BannerRequest) (*gw.BannerResponse, error) {
@@ -169,9 +314,13 @@ func (s *grpcserver) CreateBanner(ctx context.Context, in *gw.BannerRequest) (*g
 		ID = uuid.NewString()
 	}
 
-	ID, err := s.app.CreateBanner(ID, in.Description)
+	ID, err := s.app.CreateBanner(ID, in.GetDescription(), in.GetTargetUrl())
+	if errors.Is(err, app.ErrInvalidTargetURL) {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot create banner, %s", err)
+	}
+
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot create banner, %s", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot create banner, %s", err)
 	}
 
 	return &gw.BannerResponse{Id: ID}, nil
@@ -184,9 +333,13 @@ func (s *grpcserver) CreateSlot(ctx context.Context, in *gw.SlotRequest) (*gw.Sl
 		ID = uuid.NewString()
 	}
 
-	ID, err := s.app.CreateSlot(ID, in.Description)
+	ID, err := s.app.CreateSlot(ID, in.GetDescription(), in.GetStrategy())
+	if errors.Is(err, bandit.ErrUnknownStrategy) {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot create slot, %s", err)
+	}
+
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot create slot, %s", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot create slot, %s", err)
 	}
 	return &gw.SlotResponse{Id: ID}, nil
 }
@@ -200,8 +353,327 @@ func (s *grpcserver) CreateSocialDemo(ctx context.Context, in *gw.SocialDemoRequ
 
 	ID, err := s.app.CreateSocialDemo(ID, in.Description)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot create social demo, %s", err)
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot create social demo, %s", err)
 	}
 
 	return &gw.SocialDemoResponse{Id: ID}, nil
 }
+
+func (s *grpcserver) ReadBanner(ctx context.Context, in *gw.ItemRequest) (*gw.Banner, error) {
+	if in.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot read banner, %s", ErrBadRequest)
+	}
+
+	banner, err := s.app.ReadBanner(in.Id)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot read banner, %s", err)
+	}
+
+	return &gw.Banner{Id: banner.ID, Description: banner.Description, TargetUrl: banner.TargetURL}, nil
+}
+
+func (s *grpcserver) ListBanners(ctx context.Context, in *gw.ListRequest) (*gw.ListBannersResponse, error) {
+	banners, nextPageToken, err := s.app.ListBanners(int(in.PageSize), in.PageToken)
+	if err != nil {
+		return nil, status.Errorf(listErrorCode(err), "cannot list banners, %s", err)
+	}
+
+	response := &gw.ListBannersResponse{Banners: make([]*gw.Banner, 0, len(banners)), NextPageToken: nextPageToken}
+
+	for _, banner := range banners {
+		response.Banners = append(response.Banners, &gw.Banner{Id: banner.ID, Description: banner.Description, TargetUrl: banner.TargetURL})
+	}
+
+	return response, nil
+}
+
+func (s *grpcserver) UpdateBanner(ctx context.Context, in *gw.BannerRequest) (*gw.MessageResponse, error) {
+	if in.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot update banner, %s", ErrBadRequest)
+	}
+
+	err := s.app.UpdateBanner(in.Id, in.Description, in.TargetUrl)
+	if errors.Is(err, app.ErrInvalidTargetURL) {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot update banner, %s", err)
+	}
+
+	if err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot update banner, %s", err)
+	}
+
+	return &gw.MessageResponse{Message: "updated"}, nil
+}
+
+func (s *grpcserver) DeleteBanner(ctx context.Context, in *gw.ItemRequest) (*gw.MessageResponse, error) {
+	if in.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot delete banner, %s", ErrBadRequest)
+	}
+
+	if err := s.app.DeleteBanner(in.Id); err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot delete banner, %s", err)
+	}
+
+	return &gw.MessageResponse{Message: "deleted"}, nil
+}
+
+func (s *grpcserver) ReadSlot(ctx context.Context, in *gw.ItemRequest) (*gw.Slot, error) {
+	if in.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot read slot, %s", ErrBadRequest)
+	}
+
+	slot, err := s.app.ReadSlot(in.Id)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot read slot, %s", err)
+	}
+
+	return &gw.Slot{Id: slot.ID, Description: slot.Description, Strategy: slot.Strategy}, nil
+}
+
+func (s *grpcserver) ListSlots(ctx context.Context, in *gw.ListRequest) (*gw.ListSlotsResponse, error) {
+	slots, nextPageToken, err := s.app.ListSlots(int(in.PageSize), in.PageToken)
+	if err != nil {
+		return nil, status.Errorf(listErrorCode(err), "cannot list slots, %s", err)
+	}
+
+	response := &gw.ListSlotsResponse{Slots: make([]*gw.Slot, 0, len(slots)), NextPageToken: nextPageToken}
+
+	for _, slot := range slots {
+		response.Slots = append(response.Slots, &gw.Slot{Id: slot.ID, Description: slot.Description, Strategy: slot.Strategy})
+	}
+
+	return response, nil
+}
+
+func (s *grpcserver) UpdateSlot(ctx context.Context, in *gw.SlotRequest) (*gw.MessageResponse, error) {
+	if in.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot update slot, %s", ErrBadRequest)
+	}
+
+	err := s.app.UpdateSlot(in.Id, in.Description, in.Strategy)
+	if errors.Is(err, bandit.ErrUnknownStrategy) {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot update slot, %s", err)
+	}
+
+	if err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot update slot, %s", err)
+	}
+
+	return &gw.MessageResponse{Message: "updated"}, nil
+}
+
+func (s *grpcserver) DeleteSlot(ctx context.Context, in *gw.ItemRequest) (*gw.MessageResponse, error) {
+	if in.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot delete slot, %s", ErrBadRequest)
+	}
+
+	if err := s.app.DeleteSlot(in.Id); err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot delete slot, %s", err)
+	}
+
+	return &gw.MessageResponse{Message: "deleted"}, nil
+}
+
+func (s *grpcserver) ReadSocialDemo(ctx context.Context, in *gw.ItemRequest) (*gw.SocialDemo, error) {
+	if in.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot read social demo, %s", ErrBadRequest)
+	}
+
+	socialDemo, err := s.app.ReadSocialDemo(in.Id)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot read social demo, %s", err)
+	}
+
+	return &gw.SocialDemo{Id: socialDemo.ID, Description: socialDemo.Description}, nil
+}
+
+func (s *grpcserver) ListSocialDemos(ctx context.Context, in *gw.ListRequest) (*gw.ListSocialDemosResponse, error) {
+	socialDemos, nextPageToken, err := s.app.ListSocialDemos(int(in.PageSize), in.PageToken)
+	if err != nil {
+		return nil, status.Errorf(listErrorCode(err), "cannot list social demos, %s", err)
+	}
+
+	response := &gw.ListSocialDemosResponse{SocialDemos: make([]*gw.SocialDemo, 0, len(socialDemos)), NextPageToken: nextPageToken}
+
+	for _, socialDemo := range socialDemos {
+		response.SocialDemos = append(response.SocialDemos, &gw.SocialDemo{Id: socialDemo.ID, Description: socialDemo.Description})
+	}
+
+	return response, nil
+}
+
+func (s *grpcserver) UpdateSocialDemo(ctx context.Context, in *gw.SocialDemoRequest) (*gw.MessageResponse, error) {
+	if in.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot update social demo, %s", ErrBadRequest)
+	}
+
+	if err := s.app.UpdateSocialDemo(in.Id, in.Description); err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot update social demo, %s", err)
+	}
+
+	return &gw.MessageResponse{Message: "updated"}, nil
+}
+
+func (s *grpcserver) DeleteSocialDemo(ctx context.Context, in *gw.ItemRequest) (*gw.MessageResponse, error) {
+	if in.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot delete social demo, %s", ErrBadRequest)
+	}
+
+	if err := s.app.DeleteSocialDemo(in.Id); err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot delete social demo, %s", err)
+	}
+
+	return &gw.MessageResponse{Message: "deleted"}, nil
+}
+
+func listErrorCode(err error) codes.Code {
+	if errors.Is(err, app.ErrInvalidPageToken) {
+		return codes.InvalidArgument
+	}
+
+	return codes.Internal
+}
+
+func (s *grpcserver) GetSlotRotation(ctx context.Context, in *gw.ItemRequest) (*gw.SlotRotationResponse, error) {
+	if in.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot get slot rotation, %s", ErrBadRequest)
+	}
+
+	strategy, banners, err := s.app.GetSlotRotation(in.Id)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot get slot rotation, %s", err)
+	}
+
+	response := &gw.SlotRotationResponse{SlotId: in.Id, Strategy: strategy, Banners: make([]*gw.RotationBanner, 0, len(banners))}
+
+	for _, banner := range banners {
+		response.Banners = append(response.Banners, &gw.RotationBanner{
+			BannerId:    banner.BannerID,
+			Description: banner.Description,
+			Views:       int64(banner.Views),
+			Clicks:      int64(banner.Clicks),
+			Ctr:         banner.CTR,
+			Score:       banner.Score,
+		})
+	}
+
+	return response, nil
+}
+
+func (s *grpcserver) GetBannerSlots(ctx context.Context, in *gw.ItemRequest) (*gw.BannerSlotsResponse, error) {
+	if in.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot get banner slots, %s", ErrBadRequest)
+	}
+
+	slots, err := s.app.GetBannerSlots(in.Id)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot get banner slots, %s", err)
+	}
+
+	response := &gw.BannerSlotsResponse{BannerId: in.Id, Slots: make([]*gw.BannerSlot, 0, len(slots))}
+
+	for _, slot := range slots {
+		response.Slots = append(response.Slots, &gw.BannerSlot{
+			SlotId:      slot.SlotID,
+			Description: slot.Description,
+			Strategy:    slot.Strategy,
+			Views:       int64(slot.Views),
+			Clicks:      int64(slot.Clicks),
+			Ctr:         slot.CTR,
+			Score:       slot.Score,
+		})
+	}
+
+	return response, nil
+}
+
+func (s *grpcserver) GetStats(ctx context.Context, in *gw.StatsRequest) (*gw.StatsResponse, error) {
+	query := sqlstorage.StatsQuery{
+		GroupBy:      in.GroupBy,
+		Bucket:       in.Bucket,
+		SlotID:       in.SlotId,
+		BannerID:     in.BannerId,
+		SocialDemoID: in.SocialDemoId,
+	}
+
+	var err error
+
+	if query.From, err = parseTime(in.From); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot get stats, from %s", err)
+	}
+
+	if query.To, err = parseTime(in.To); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot get stats, to %s", err)
+	}
+
+	rows, err := s.app.GetStats(query)
+	if err != nil {
+		code := codes.Internal
+		if errors.Is(err, sqlstorage.ErrInvalidStatsQuery) {
+			code = codes.InvalidArgument
+		}
+
+		return nil, status.Errorf(code, "cannot get stats, %s", err)
+	}
+
+	response := &gw.StatsResponse{Rows: make([]*gw.StatsRow, 0, len(rows))}
+
+	for _, row := range rows {
+		statsRow := &gw.StatsRow{
+			SlotId:       row.SlotID,
+			BannerId:     row.BannerID,
+			SocialDemoId: row.SocialDemoID,
+			Views:        int64(row.Views),
+			Clicks:       int64(row.Clicks),
+			Ctr:          row.CTR,
+		}
+
+		if row.Time != nil {
+			statsRow.Time = row.Time.UTC().Format(time.RFC3339)
+		}
+
+		response.Rows = append(response.Rows, statsRow)
+	}
+
+	return response, nil
+}
+
+// parseTime parses RFC 3339 time, empty value is zero time.
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
+
+func (s *grpcserver) ExplainSelection(ctx context.Context, in *gw.ExplainSelectionRequest) (*gw.ExplainSelectionResponse, error) {
+	if in.SlotId == "" || in.SocialDemoId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot explain selection, %s", ErrBadRequest)
+	}
+
+	explanation, err := s.app.ExplainSelection(in.SlotId, in.SocialDemoId, in.Features)
+	if err != nil {
+		return nil, status.Errorf(errorCode(err, codes.Internal), "cannot explain selection, %s", err)
+	}
+
+	response := &gw.ExplainSelectionResponse{
+		SlotId:       in.SlotId,
+		SocialDemoId: in.SocialDemoId,
+		Strategy:     explanation.Strategy,
+		BySocialDemo: explanation.BySocialDemo,
+		Candidates:   make([]*gw.ExplainCandidate, 0, len(explanation.Candidates)),
+	}
+
+	for _, candidate := range explanation.Candidates {
+		response.Candidates = append(response.Candidates, &gw.ExplainCandidate{
+			BannerId:     candidate.BannerID,
+			Views:        candidate.Views,
+			Clicks:       candidate.Clicks,
+			Exploitation: candidate.Exploitation,
+			Exploration:  candidate.Exploration,
+			Score:        candidate.Score,
+		})
+	}
+
+	return response, nil
+}
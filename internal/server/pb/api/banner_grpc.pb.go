@@ -21,10 +21,29 @@ type BannersRotationClient interface {
 	AddBanner(ctx context.Context, in *AddBannerRequest, opts ...grpc.CallOption) (*MessageResponse, error)
 	RemoveBanner(ctx context.Context, in *RemoveBannerRequest, opts ...grpc.CallOption) (*MessageResponse, error)
 	ClickEvent(ctx context.Context, in *ClickEventRequest, opts ...grpc.CallOption) (*MessageResponse, error)
-	GetBanner(ctx context.Context, in *GetBannerRequest, opts ...grpc.CallOption) (*BannerResponse, error)
+	GetBanner(ctx context.Context, in *GetBannerRequest, opts ...grpc.CallOption) (*ServedBannerResponse, error)
+	GetBanners(ctx context.Context, in *GetBannersRequest, opts ...grpc.CallOption) (*BannersResponse, error)
+	GetBannersBatch(ctx context.Context, in *GetBannersBatchRequest, opts ...grpc.CallOption) (*BannersBatchResponse, error)
 	CreateBanner(ctx context.Context, in *BannerRequest, opts ...grpc.CallOption) (*BannerResponse, error)
 	CreateSlot(ctx context.Context, in *SlotRequest, opts ...grpc.CallOption) (*SlotResponse, error)
 	CreateSocialDemo(ctx context.Context, in *SocialDemoRequest, opts ...grpc.CallOption) (*SocialDemoResponse, error)
+	ReadBanner(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*Banner, error)
+	ListBanners(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListBannersResponse, error)
+	UpdateBanner(ctx context.Context, in *BannerRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	DeleteBanner(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	ReadSlot(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*Slot, error)
+	ListSlots(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListSlotsResponse, error)
+	UpdateSlot(ctx context.Context, in *SlotRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	DeleteSlot(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	ReadSocialDemo(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*SocialDemo, error)
+	ListSocialDemos(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListSocialDemosResponse, error)
+	UpdateSocialDemo(ctx context.Context, in *SocialDemoRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	DeleteSocialDemo(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	GetSlotRotation(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*SlotRotationResponse, error)
+	GetBannerSlots(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*BannerSlotsResponse, error)
+	GetStats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
+	ExplainSelection(ctx context.Context, in *ExplainSelectionRequest, opts ...grpc.CallOption) (*ExplainSelectionResponse, error)
+	RecordImpression(ctx context.Context, in *ImpressionRequest, opts ...grpc.CallOption) (*ImpressionResponse, error)
 }
 
 type bannersRotationClient struct {
@@ -62,8 +81,8 @@ func (c *bannersRotationClient) ClickEvent(ctx context.Context, in *ClickEventRe
 	return out, nil
 }
 
-func (c *bannersRotationClient) GetBanner(ctx context.Context, in *GetBannerRequest, opts ...grpc.CallOption) (*BannerResponse, error) {
-	out := new(BannerResponse)
+func (c *bannersRotationClient) GetBanner(ctx context.Context, in *GetBannerRequest, opts ...grpc.CallOption) (*ServedBannerResponse, error) {
+	out := new(ServedBannerResponse)
 	err := c.cc.Invoke(ctx, "/banner.BannersRotation/GetBanner", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -71,6 +90,24 @@ func (c *bannersRotationClient) GetBanner(ctx context.Context, in *GetBannerRequ
 	return out, nil
 }
 
+func (c *bannersRotationClient) GetBanners(ctx context.Context, in *GetBannersRequest, opts ...grpc.CallOption) (*BannersResponse, error) {
+	out := new(BannersResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/GetBanners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) GetBannersBatch(ctx context.Context, in *GetBannersBatchRequest, opts ...grpc.CallOption) (*BannersBatchResponse, error) {
+	out := new(BannersBatchResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/GetBannersBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bannersRotationClient) CreateBanner(ctx context.Context, in *BannerRequest, opts ...grpc.CallOption) (*BannerResponse, error) {
 	out := new(BannerResponse)
 	err := c.cc.Invoke(ctx, "/banner.BannersRotation/CreateBanner", in, out, opts...)
@@ -98,6 +135,159 @@ func (c *bannersRotationClient) CreateSocialDemo(ctx context.Context, in *Social
 	return out, nil
 }
 
+func (c *bannersRotationClient) ReadBanner(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*Banner, error) {
+	out := new(Banner)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/ReadBanner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) ListBanners(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListBannersResponse, error) {
+	out := new(ListBannersResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/ListBanners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) UpdateBanner(ctx context.Context, in *BannerRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/UpdateBanner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) DeleteBanner(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/DeleteBanner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) ReadSlot(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*Slot, error) {
+	out := new(Slot)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/ReadSlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) ListSlots(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListSlotsResponse, error) {
+	out := new(ListSlotsResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/ListSlots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) UpdateSlot(ctx context.Context, in *SlotRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/UpdateSlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) DeleteSlot(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/DeleteSlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) ReadSocialDemo(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*SocialDemo, error) {
+	out := new(SocialDemo)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/ReadSocialDemo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) ListSocialDemos(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListSocialDemosResponse, error) {
+	out := new(ListSocialDemosResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/ListSocialDemos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) UpdateSocialDemo(ctx context.Context, in *SocialDemoRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/UpdateSocialDemo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) DeleteSocialDemo(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/DeleteSocialDemo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) GetSlotRotation(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*SlotRotationResponse, error) {
+	out := new(SlotRotationResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/GetSlotRotation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) GetBannerSlots(ctx context.Context, in *ItemRequest, opts ...grpc.CallOption) (*BannerSlotsResponse, error) {
+	out := new(BannerSlotsResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/GetBannerSlots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) GetStats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
+	out := new(StatsResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/GetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) ExplainSelection(ctx context.Context, in *ExplainSelectionRequest, opts ...grpc.CallOption) (*ExplainSelectionResponse, error) {
+	out := new(ExplainSelectionResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/ExplainSelection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bannersRotationClient) RecordImpression(ctx context.Context, in *ImpressionRequest, opts ...grpc.CallOption) (*ImpressionResponse, error) {
+	out := new(ImpressionResponse)
+	err := c.cc.Invoke(ctx, "/banner.BannersRotation/RecordImpression", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BannersRotationServer is the server API for BannersRotation service.
 // All implementations must embed UnimplementedBannersRotationServer
 // for forward compatibility
@@ -105,10 +295,29 @@ type BannersRotationServer interface {
 	AddBanner(context.Context, *AddBannerRequest) (*MessageResponse, error)
 	RemoveBanner(context.Context, *RemoveBannerRequest) (*MessageResponse, error)
 	ClickEvent(context.Context, *ClickEventRequest) (*MessageResponse, error)
-	GetBanner(context.Context, *GetBannerRequest) (*BannerResponse, error)
+	GetBanner(context.Context, *GetBannerRequest) (*ServedBannerResponse, error)
+	GetBanners(context.Context, *GetBannersRequest) (*BannersResponse, error)
+	GetBannersBatch(context.Context, *GetBannersBatchRequest) (*BannersBatchResponse, error)
 	CreateBanner(context.Context, *BannerRequest) (*BannerResponse, error)
 	CreateSlot(context.Context, *SlotRequest) (*SlotResponse, error)
 	CreateSocialDemo(context.Context, *SocialDemoRequest) (*SocialDemoResponse, error)
+	ReadBanner(context.Context, *ItemRequest) (*Banner, error)
+	ListBanners(context.Context, *ListRequest) (*ListBannersResponse, error)
+	UpdateBanner(context.Context, *BannerRequest) (*MessageResponse, error)
+	DeleteBanner(context.Context, *ItemRequest) (*MessageResponse, error)
+	ReadSlot(context.Context, *ItemRequest) (*Slot, error)
+	ListSlots(context.Context, *ListRequest) (*ListSlotsResponse, error)
+	UpdateSlot(context.Context, *SlotRequest) (*MessageResponse, error)
+	DeleteSlot(context.Context, *ItemRequest) (*MessageResponse, error)
+	ReadSocialDemo(context.Context, *ItemRequest) (*SocialDemo, error)
+	ListSocialDemos(context.Context, *ListRequest) (*ListSocialDemosResponse, error)
+	UpdateSocialDemo(context.Context, *SocialDemoRequest) (*MessageResponse, error)
+	DeleteSocialDemo(context.Context, *ItemRequest) (*MessageResponse, error)
+	GetSlotRotation(context.Context, *ItemRequest) (*SlotRotationResponse, error)
+	GetBannerSlots(context.Context, *ItemRequest) (*BannerSlotsResponse, error)
+	GetStats(context.Context, *StatsRequest) (*StatsResponse, error)
+	ExplainSelection(context.Context, *ExplainSelectionRequest) (*ExplainSelectionResponse, error)
+	RecordImpression(context.Context, *ImpressionRequest) (*ImpressionResponse, error)
 	mustEmbedUnimplementedBannersRotationServer()
 }
 
@@ -125,9 +334,15 @@ func (UnimplementedBannersRotationServer) RemoveBanner(context.Context, *RemoveB
 func (UnimplementedBannersRotationServer) ClickEvent(context.Context, *ClickEventRequest) (*MessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClickEvent not implemented")
 }
-func (UnimplementedBannersRotationServer) GetBanner(context.Context, *GetBannerRequest) (*BannerResponse, error) {
+func (UnimplementedBannersRotationServer) GetBanner(context.Context, *GetBannerRequest) (*ServedBannerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBanner not implemented")
 }
+func (UnimplementedBannersRotationServer) GetBanners(context.Context, *GetBannersRequest) (*BannersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBanners not implemented")
+}
+func (UnimplementedBannersRotationServer) GetBannersBatch(context.Context, *GetBannersBatchRequest) (*BannersBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBannersBatch not implemented")
+}
 func (UnimplementedBannersRotationServer) CreateBanner(context.Context, *BannerRequest) (*BannerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBanner not implemented")
 }
@@ -137,6 +352,57 @@ func (UnimplementedBannersRotationServer) CreateSlot(context.Context, *SlotReque
 func (UnimplementedBannersRotationServer) CreateSocialDemo(context.Context, *SocialDemoRequest) (*SocialDemoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSocialDemo not implemented")
 }
+func (UnimplementedBannersRotationServer) ReadBanner(context.Context, *ItemRequest) (*Banner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadBanner not implemented")
+}
+func (UnimplementedBannersRotationServer) ListBanners(context.Context, *ListRequest) (*ListBannersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBanners not implemented")
+}
+func (UnimplementedBannersRotationServer) UpdateBanner(context.Context, *BannerRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBanner not implemented")
+}
+func (UnimplementedBannersRotationServer) DeleteBanner(context.Context, *ItemRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBanner not implemented")
+}
+func (UnimplementedBannersRotationServer) ReadSlot(context.Context, *ItemRequest) (*Slot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadSlot not implemented")
+}
+func (UnimplementedBannersRotationServer) ListSlots(context.Context, *ListRequest) (*ListSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSlots not implemented")
+}
+func (UnimplementedBannersRotationServer) UpdateSlot(context.Context, *SlotRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSlot not implemented")
+}
+func (UnimplementedBannersRotationServer) DeleteSlot(context.Context, *ItemRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSlot not implemented")
+}
+func (UnimplementedBannersRotationServer) ReadSocialDemo(context.Context, *ItemRequest) (*SocialDemo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadSocialDemo not implemented")
+}
+func (UnimplementedBannersRotationServer) ListSocialDemos(context.Context, *ListRequest) (*ListSocialDemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSocialDemos not implemented")
+}
+func (UnimplementedBannersRotationServer) UpdateSocialDemo(context.Context, *SocialDemoRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSocialDemo not implemented")
+}
+func (UnimplementedBannersRotationServer) DeleteSocialDemo(context.Context, *ItemRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSocialDemo not implemented")
+}
+func (UnimplementedBannersRotationServer) GetSlotRotation(context.Context, *ItemRequest) (*SlotRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSlotRotation not implemented")
+}
+func (UnimplementedBannersRotationServer) GetBannerSlots(context.Context, *ItemRequest) (*BannerSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBannerSlots not implemented")
+}
+func (UnimplementedBannersRotationServer) GetStats(context.Context, *StatsRequest) (*StatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedBannersRotationServer) ExplainSelection(context.Context, *ExplainSelectionRequest) (*ExplainSelectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainSelection not implemented")
+}
+func (UnimplementedBannersRotationServer) RecordImpression(context.Context, *ImpressionRequest) (*ImpressionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordImpression not implemented")
+}
 func (UnimplementedBannersRotationServer) mustEmbedUnimplementedBannersRotationServer() {}
 
 // UnsafeBannersRotationServer may be embedded to opt out of forward compatibility for this service.
@@ -222,6 +488,42 @@ func _BannersRotation_GetBanner_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BannersRotation_GetBanners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBannersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).GetBanners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/GetBanners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).GetBanners(ctx, req.(*GetBannersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_GetBannersBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBannersBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).GetBannersBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/GetBannersBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).GetBannersBatch(ctx, req.(*GetBannersBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BannersRotation_CreateBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BannerRequest)
 	if err := dec(in); err != nil {
@@ -276,6 +578,312 @@ func _BannersRotation_CreateSocialDemo_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BannersRotation_ReadBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).ReadBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/ReadBanner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).ReadBanner(ctx, req.(*ItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_ListBanners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).ListBanners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/ListBanners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).ListBanners(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_UpdateBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BannerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).UpdateBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/UpdateBanner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).UpdateBanner(ctx, req.(*BannerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_DeleteBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).DeleteBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/DeleteBanner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).DeleteBanner(ctx, req.(*ItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_ReadSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).ReadSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/ReadSlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).ReadSlot(ctx, req.(*ItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_ListSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).ListSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/ListSlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).ListSlots(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_UpdateSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).UpdateSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/UpdateSlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).UpdateSlot(ctx, req.(*SlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_DeleteSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).DeleteSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/DeleteSlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).DeleteSlot(ctx, req.(*ItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_ReadSocialDemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).ReadSocialDemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/ReadSocialDemo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).ReadSocialDemo(ctx, req.(*ItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_ListSocialDemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).ListSocialDemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/ListSocialDemos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).ListSocialDemos(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_UpdateSocialDemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SocialDemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).UpdateSocialDemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/UpdateSocialDemo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).UpdateSocialDemo(ctx, req.(*SocialDemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_DeleteSocialDemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).DeleteSocialDemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/DeleteSocialDemo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).DeleteSocialDemo(ctx, req.(*ItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_GetSlotRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).GetSlotRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/GetSlotRotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).GetSlotRotation(ctx, req.(*ItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_GetBannerSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).GetBannerSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/GetBannerSlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).GetBannerSlots(ctx, req.(*ItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/GetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).GetStats(ctx, req.(*StatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_ExplainSelection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainSelectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).ExplainSelection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/ExplainSelection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).ExplainSelection(ctx, req.(*ExplainSelectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BannersRotation_RecordImpression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BannersRotationServer).RecordImpression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/banner.BannersRotation/RecordImpression",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BannersRotationServer).RecordImpression(ctx, req.(*ImpressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BannersRotation_ServiceDesc is the grpc.ServiceDesc for BannersRotation service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -299,6 +907,14 @@ var BannersRotation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBanner",
 			Handler:    _BannersRotation_GetBanner_Handler,
 		},
+		{
+			MethodName: "GetBanners",
+			Handler:    _BannersRotation_GetBanners_Handler,
+		},
+		{
+			MethodName: "GetBannersBatch",
+			Handler:    _BannersRotation_GetBannersBatch_Handler,
+		},
 		{
 			MethodName: "CreateBanner",
 			Handler:    _BannersRotation_CreateBanner_Handler,
@@ -311,6 +927,74 @@ var BannersRotation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSocialDemo",
 			Handler:    _BannersRotation_CreateSocialDemo_Handler,
 		},
+		{
+			MethodName: "ReadBanner",
+			Handler:    _BannersRotation_ReadBanner_Handler,
+		},
+		{
+			MethodName: "ListBanners",
+			Handler:    _BannersRotation_ListBanners_Handler,
+		},
+		{
+			MethodName: "UpdateBanner",
+			Handler:    _BannersRotation_UpdateBanner_Handler,
+		},
+		{
+			MethodName: "DeleteBanner",
+			Handler:    _BannersRotation_DeleteBanner_Handler,
+		},
+		{
+			MethodName: "ReadSlot",
+			Handler:    _BannersRotation_ReadSlot_Handler,
+		},
+		{
+			MethodName: "ListSlots",
+			Handler:    _BannersRotation_ListSlots_Handler,
+		},
+		{
+			MethodName: "UpdateSlot",
+			Handler:    _BannersRotation_UpdateSlot_Handler,
+		},
+		{
+			MethodName: "DeleteSlot",
+			Handler:    _BannersRotation_DeleteSlot_Handler,
+		},
+		{
+			MethodName: "ReadSocialDemo",
+			Handler:    _BannersRotation_ReadSocialDemo_Handler,
+		},
+		{
+			MethodName: "ListSocialDemos",
+			Handler:    _BannersRotation_ListSocialDemos_Handler,
+		},
+		{
+			MethodName: "UpdateSocialDemo",
+			Handler:    _BannersRotation_UpdateSocialDemo_Handler,
+		},
+		{
+			MethodName: "DeleteSocialDemo",
+			Handler:    _BannersRotation_DeleteSocialDemo_Handler,
+		},
+		{
+			MethodName: "GetSlotRotation",
+			Handler:    _BannersRotation_GetSlotRotation_Handler,
+		},
+		{
+			MethodName: "GetBannerSlots",
+			Handler:    _BannersRotation_GetBannerSlots_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _BannersRotation_GetStats_Handler,
+		},
+		{
+			MethodName: "ExplainSelection",
+			Handler:    _BannersRotation_ExplainSelection_Handler,
+		},
+		{
+			MethodName: "RecordImpression",
+			Handler:    _BannersRotation_RecordImpression_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/banner.proto",
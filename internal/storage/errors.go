@@ -0,0 +1,10 @@
+package storage
+
+import "errors"
+
+var (
+	// ErrNotFound is returned when an item or an item it refers to does not exist.
+	ErrNotFound = errors.New("item not found")
+	// ErrAlreadyExists is returned when an item with the same key is already stored.
+	ErrAlreadyExists = errors.New("item already exists")
+)
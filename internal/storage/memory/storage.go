@@ -0,0 +1,1088 @@
+package memorystorage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Fuchsoria/banners-rotation/internal/storage"
+	sqlstorage "github.com/Fuchsoria/banners-rotation/internal/storage/sql"
+)
+
+type Storage struct {
+	mu          sync.RWMutex
+	banners     map[string]banner
+	slots       map[string]slot
+	socialDemos map[string]string
+	rotations   []sqlstorage.BannerRotationItem
+	clicks      []event
+	views       []event
+	stats       map[statsKey]*sqlstorage.StatsItem
+	models      map[modelKey][]byte
+	rollups     map[rollupKey]*sqlstorage.RollupRow
+	// processedEvents are ids of events added to rollups with time of processing
+	processedEvents map[int64]time.Time
+	// outbox has pending events, sent ones are removed
+	outbox   []outboxItem
+	outboxID int64
+	// clickedImpressions and viewedImpressions are ids of impressions which events are added
+	clickedImpressions map[string]bool
+	viewedImpressions  map[string]bool
+}
+
+type banner struct {
+	description string
+	targetURL   string
+}
+
+func (b banner) item(id string) sqlstorage.BannerItem {
+	return sqlstorage.BannerItem{ID: id, Description: b.description, TargetURL: b.targetURL}
+}
+
+// event is click or view.
+type event struct {
+	SlotID       string
+	BannerID     string
+	SocialDemoID string
+	Date         time.Time
+}
+
+type slot struct {
+	description string
+	strategy    string
+}
+
+type statsKey struct {
+	slotID       string
+	bannerID     string
+	socialDemoID string
+}
+
+type rollupKey struct {
+	statsKey
+	hour time.Time
+}
+
+type modelKey struct {
+	slotID   string
+	strategy string
+}
+
+func New() *Storage {
+	return &Storage{
+		banners:            make(map[string]banner),
+		slots:              make(map[string]slot),
+		socialDemos:        make(map[string]string),
+		stats:              make(map[statsKey]*sqlstorage.StatsItem),
+		models:             make(map[modelKey][]byte),
+		rollups:            make(map[rollupKey]*sqlstorage.RollupRow),
+		processedEvents:    make(map[int64]time.Time),
+		clickedImpressions: make(map[string]bool),
+		viewedImpressions:  make(map[string]bool),
+	}
+}
+
+func (s *Storage) Connect(ctx context.Context) error {
+	return nil
+}
+
+func (s *Storage) Close() error {
+	return nil
+}
+
+func (s *Storage) AddBannerRotation(bannerID string, slotID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkItems(slotID, bannerID); err != nil {
+		return fmt.Errorf("cannot insert banner to rotation, %w", err)
+	}
+
+	for _, item := range s.rotations {
+		if item.SlotID == slotID && item.BannerID == bannerID {
+			return fmt.Errorf("cannot insert banner to rotation, %w", storage.ErrAlreadyExists)
+		}
+	}
+
+	s.rotations = append(s.rotations, sqlstorage.BannerRotationItem{SlotID: slotID, BannerID: bannerID})
+
+	return nil
+}
+
+func (s *Storage) RemoveBannerRotation(bannerID string, slotID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rotations := s.rotations[:0]
+
+	for _, item := range s.rotations {
+		if item.SlotID != slotID || item.BannerID != bannerID {
+			rotations = append(rotations, item)
+		}
+	}
+
+	removed := len(s.rotations) - len(rotations)
+	s.rotations = rotations
+
+	if removed == 0 {
+		return fmt.Errorf("rows are not affected on rotation delete, %w", sqlstorage.ErrBannersWereRemoved)
+	}
+
+	return nil
+}
+
+func (s *Storage) AddClickEvent(bannerID string, slotID string, socialDemoID string, date time.Time) error {
+	return s.addClickEvent("", bannerID, slotID, socialDemoID, date)
+}
+
+func (s *Storage) AddImpressionClickEvent(impressionID string, bannerID string, slotID string, socialDemoID string, date time.Time) error {
+	return s.addClickEvent(impressionID, bannerID, slotID, socialDemoID, date)
+}
+
+func (s *Storage) addClickEvent(impressionID string, bannerID string, slotID string, socialDemoID string, date time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkEventItems(slotID, bannerID, socialDemoID); err != nil {
+		return fmt.Errorf("cannot insert banner click, %w", err)
+	}
+
+	if impressionID != "" {
+		if s.clickedImpressions[impressionID] {
+			return fmt.Errorf("cannot insert banner click, impression %q, %w", impressionID, storage.ErrAlreadyExists)
+		}
+
+		s.clickedImpressions[impressionID] = true
+	}
+
+	s.clicks = append(s.clicks, event{SlotID: slotID, BannerID: bannerID, SocialDemoID: socialDemoID, Date: date})
+	s.getStats(slotID, bannerID, socialDemoID).Clicks++
+	s.addOutbox(sqlstorage.EventClick, bannerID, slotID, socialDemoID, date)
+
+	return nil
+}
+
+func (s *Storage) AddViewEvent(bannerID string, slotID string, socialDemoID string, date time.Time) error {
+	return s.addViewEvent("", bannerID, slotID, socialDemoID, date)
+}
+
+func (s *Storage) AddImpressionViewEvent(impressionID string, bannerID string, slotID string, socialDemoID string, date time.Time) error {
+	return s.addViewEvent(impressionID, bannerID, slotID, socialDemoID, date)
+}
+
+// HasImpressionView reports whether view of impression is added.
+func (s *Storage) HasImpressionView(impressionID string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.viewedImpressions[impressionID], nil
+}
+
+func (s *Storage) addViewEvent(impressionID string, bannerID string, slotID string, socialDemoID string, date time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkEventItems(slotID, bannerID, socialDemoID); err != nil {
+		return fmt.Errorf("cannot insert banner view, %w", err)
+	}
+
+	if impressionID != "" {
+		if s.viewedImpressions[impressionID] {
+			return fmt.Errorf("cannot insert banner view, impression %q, %w", impressionID, storage.ErrAlreadyExists)
+		}
+
+		s.viewedImpressions[impressionID] = true
+	}
+
+	s.views = append(s.views, event{SlotID: slotID, BannerID: bannerID, SocialDemoID: socialDemoID, Date: date})
+	s.getStats(slotID, bannerID, socialDemoID).Views++
+	s.addOutbox(sqlstorage.EventView, bannerID, slotID, socialDemoID, date)
+
+	return nil
+}
+
+// checkItems works like foreign keys of sql storage.
+func (s *Storage) checkItems(slotID string, bannerID string) error {
+	if _, ok := s.slots[slotID]; !ok {
+		return fmt.Errorf("slot %q, %w", slotID, storage.ErrNotFound)
+	}
+
+	if _, ok := s.banners[bannerID]; !ok {
+		return fmt.Errorf("banner %q, %w", bannerID, storage.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (s *Storage) checkEventItems(slotID string, bannerID string, socialDemoID string) error {
+	if _, ok := s.socialDemos[socialDemoID]; !ok {
+		return fmt.Errorf("social demo %q, %w", socialDemoID, storage.ErrNotFound)
+	}
+
+	return s.checkItems(slotID, bannerID)
+}
+
+// counted reports whether slot, banner and social demo of event exist,
+// events are kept after deletion of items but they are not counted.
+func (s *Storage) counted(item event) bool {
+	return s.checkEventItems(item.SlotID, item.BannerID, item.SocialDemoID) == nil
+}
+
+func (s *Storage) getStats(slotID string, bannerID string, socialDemoID string) *sqlstorage.StatsItem {
+	key := statsKey{slotID, bannerID, socialDemoID}
+
+	item, ok := s.stats[key]
+	if !ok {
+		item = &sqlstorage.StatsItem{SlotID: slotID, BannerID: bannerID, SocialDemoID: socialDemoID}
+		s.stats[key] = item
+	}
+
+	return item
+}
+
+func (s *Storage) GetBannersInSlot(slotID string) (bannersInSlot []sqlstorage.BannerRotationItem, err error) {
+	return s.GetBannersInSlots([]string{slotID})
+}
+
+func (s *Storage) GetBannersInSlots(slotIDs []string) (bannersInSlots []sqlstorage.BannerRotationItem, err error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	slots := toSet(slotIDs)
+
+	for _, item := range s.rotations {
+		if slots[item.SlotID] {
+			bannersInSlots = append(bannersInSlots, item)
+		}
+	}
+
+	return bannersInSlots, nil
+}
+
+func (s *Storage) GetSlotStats(slotID string) (stats []sqlstorage.StatsItem, err error) {
+	return s.GetSlotsStats([]string{slotID})
+}
+
+func (s *Storage) GetSlotsStats(slotIDs []string) (stats []sqlstorage.StatsItem, err error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	slots := toSet(slotIDs)
+
+	for _, item := range s.stats {
+		if slots[item.SlotID] {
+			stats = append(stats, *item)
+		}
+	}
+
+	return stats, nil
+}
+
+func (s *Storage) BackfillBannerStats() (rows int64, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stats = make(map[statsKey]*sqlstorage.StatsItem)
+
+	for _, item := range s.views {
+		if s.counted(item) {
+			s.getStats(item.SlotID, item.BannerID, item.SocialDemoID).Views++
+		}
+	}
+
+	for _, item := range s.clicks {
+		if s.counted(item) {
+			s.getStats(item.SlotID, item.BannerID, item.SocialDemoID).Clicks++
+		}
+	}
+
+	return int64(len(s.stats)), nil
+}
+
+func (s *Storage) GetBannersTimeStats(slotID string, since time.Time) ([]sqlstorage.TimeStatsItem, error) {
+	return s.getTimeStats(since, func(itemSlotID string, itemSocialDemoID string) bool {
+		return itemSlotID == slotID
+	})
+}
+
+func (s *Storage) GetBannersTimeStatsBySocialDemo(slotID string, socialDemoID string, since time.Time) ([]sqlstorage.TimeStatsItem, error) {
+	return s.getTimeStats(since, func(itemSlotID string, itemSocialDemoID string) bool {
+		return itemSlotID == slotID && itemSocialDemoID == socialDemoID
+	})
+}
+
+type timeStatsKey struct {
+	bannerID string
+	hour     time.Time
+}
+
+func (s *Storage) getTimeStats(since time.Time, match func(slotID string, socialDemoID string) bool) ([]sqlstorage.TimeStatsItem, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	since = since.Truncate(time.Hour)
+	buckets := make(map[timeStatsKey]*sqlstorage.TimeStatsItem)
+
+	bucket := func(bannerID string, date time.Time) *sqlstorage.TimeStatsItem {
+		hour := date.Truncate(time.Hour)
+		if hour.Before(since) {
+			return nil
+		}
+
+		key := timeStatsKey{bannerID, hour}
+
+		item, ok := buckets[key]
+		if !ok {
+			item = &sqlstorage.TimeStatsItem{BannerID: bannerID, Hour: hour}
+			buckets[key] = item
+		}
+
+		return item
+	}
+
+	for _, view := range s.views {
+		if match(view.SlotID, view.SocialDemoID) && s.counted(view) {
+			if item := bucket(view.BannerID, view.Date); item != nil {
+				item.Views++
+			}
+		}
+	}
+
+	for _, click := range s.clicks {
+		if match(click.SlotID, click.SocialDemoID) && s.counted(click) {
+			if item := bucket(click.BannerID, click.Date); item != nil {
+				item.Clicks++
+			}
+		}
+	}
+
+	stats := make([]sqlstorage.TimeStatsItem, 0, len(buckets))
+
+	for _, item := range buckets {
+		stats = append(stats, *item)
+	}
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Hour.Before(stats[j].Hour) })
+
+	return stats, nil
+}
+
+type statsRowKey struct {
+	statsKey
+	time time.Time
+}
+
+func (s *Storage) GetStats(query sqlstorage.StatsQuery) ([]sqlstorage.StatsRow, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	groupBy := toSet(query.GroupBy)
+	rows := make(map[statsRowKey]*sqlstorage.StatsRow)
+
+	// aggregation without groups has a single row like sql one
+	if len(groupBy) == 0 && query.Bucket == "" {
+		rows[statsRowKey{}] = &sqlstorage.StatsRow{}
+	}
+
+	row := func(slotID string, bannerID string, socialDemoID string, date time.Time) *sqlstorage.StatsRow {
+		if !matchStatsQuery(query, slotID, bannerID, socialDemoID, date) {
+			return nil
+		}
+
+		var key statsRowKey
+
+		if groupBy[sqlstorage.StatsBySlot] {
+			key.slotID = slotID
+		}
+
+		if groupBy[sqlstorage.StatsByBanner] {
+			key.bannerID = bannerID
+		}
+
+		if groupBy[sqlstorage.StatsBySocialDemo] {
+			key.socialDemoID = socialDemoID
+		}
+
+		if query.Bucket != "" {
+			key.time = sqlstorage.BucketStart(date, query.Bucket)
+		}
+
+		item, ok := rows[key]
+		if !ok {
+			item = &sqlstorage.StatsRow{SlotID: key.slotID, BannerID: key.bannerID, SocialDemoID: key.socialDemoID}
+
+			if query.Bucket != "" {
+				bucketTime := key.time
+				item.Time = &bucketTime
+			}
+
+			rows[key] = item
+		}
+
+		return item
+	}
+
+	for _, view := range s.views {
+		if !s.counted(view) {
+			continue
+		}
+
+		if item := row(view.SlotID, view.BannerID, view.SocialDemoID, view.Date); item != nil {
+			item.Views++
+		}
+	}
+
+	for _, click := range s.clicks {
+		if !s.counted(click) {
+			continue
+		}
+
+		if item := row(click.SlotID, click.BannerID, click.SocialDemoID, click.Date); item != nil {
+			item.Clicks++
+		}
+	}
+
+	stats := make([]sqlstorage.StatsRow, 0, len(rows))
+
+	for _, item := range rows {
+		stats = append(stats, *item)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		a, b := stats[i], stats[j]
+
+		switch {
+		case a.SlotID != b.SlotID:
+			return a.SlotID < b.SlotID
+		case a.BannerID != b.BannerID:
+			return a.BannerID < b.BannerID
+		case a.SocialDemoID != b.SocialDemoID:
+			return a.SocialDemoID < b.SocialDemoID
+		case a.Time != nil && b.Time != nil:
+			return a.Time.Before(*b.Time)
+		default:
+			return false
+		}
+	})
+
+	return stats, nil
+}
+
+func (s *Storage) StreamStats(query sqlstorage.StatsQuery, fn func(row sqlstorage.StatsRow) error) error {
+	rows, err := s.GetStats(query)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) StreamEvents(query sqlstorage.StatsQuery, fn func(row sqlstorage.EventRow) error) error {
+	if err := query.Validate(); err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+
+	events := []sqlstorage.EventRow{}
+
+	for _, view := range s.views {
+		if matchStatsQuery(query, view.SlotID, view.BannerID, view.SocialDemoID, view.Date) && s.counted(view) {
+			events = append(events, sqlstorage.EventRow{
+				Type: sqlstorage.EventView, SlotID: view.SlotID, BannerID: view.BannerID, SocialDemoID: view.SocialDemoID, Date: view.Date,
+			})
+		}
+	}
+
+	for _, click := range s.clicks {
+		if matchStatsQuery(query, click.SlotID, click.BannerID, click.SocialDemoID, click.Date) && s.counted(click) {
+			events = append(events, sqlstorage.EventRow{
+				Type: sqlstorage.EventClick, SlotID: click.SlotID, BannerID: click.BannerID, SocialDemoID: click.SocialDemoID, Date: click.Date,
+			})
+		}
+	}
+
+	s.mu.RUnlock()
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Date.Before(events[j].Date) })
+
+	for _, event := range events {
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func matchStatsQuery(query sqlstorage.StatsQuery, slotID string, bannerID string, socialDemoID string, date time.Time) bool {
+	return (query.From.IsZero() || !date.Before(query.From)) && (query.To.IsZero() || date.Before(query.To)) &&
+		(query.SlotID == "" || slotID == query.SlotID) &&
+		(query.BannerID == "" || bannerID == query.BannerID) &&
+		(query.SocialDemoID == "" || socialDemoID == query.SocialDemoID)
+}
+
+func (s *Storage) GetSlotsStrategies(slotIDs []string) (strategies []sqlstorage.SlotStrategyItem, err error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, slotID := range slotIDs {
+		if slot, ok := s.slots[slotID]; ok {
+			strategies = append(strategies, sqlstorage.SlotStrategyItem{SlotID: slotID, Strategy: slot.strategy})
+		}
+	}
+
+	return strategies, nil
+}
+
+func (s *Storage) GetSlotStrategy(slotID string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.slots[slotID].strategy, nil
+}
+
+func (s *Storage) GetBanditModel(slotID string, strategy string) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.models[modelKey{slotID, strategy}], nil
+}
+
+func (s *Storage) UpdateBanditModel(slotID string, strategy string, update func(model []byte) ([]byte, error)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.slots[slotID]; !ok {
+		return fmt.Errorf("cannot update bandit model, slot %q, %w", slotID, storage.ErrNotFound)
+	}
+
+	key := modelKey{slotID, strategy}
+
+	model, err := update(append([]byte(nil), s.models[key]...))
+	if err != nil {
+		return fmt.Errorf("cannot update bandit model, %w", err)
+	}
+
+	s.models[key] = append([]byte(nil), model...)
+
+	return nil
+}
+
+func (s *Storage) CreateBanner(id string, description string, targetURL string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.banners[id]; ok {
+		return "", fmt.Errorf("cannot insert banner, %w", storage.ErrAlreadyExists)
+	}
+
+	s.banners[id] = banner{description: description, targetURL: targetURL}
+
+	return id, nil
+}
+
+func (s *Storage) CreateSlot(id string, description string, strategy string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.slots[id]; ok {
+		return "", fmt.Errorf("cannot insert slot, %w", storage.ErrAlreadyExists)
+	}
+
+	s.slots[id] = slot{description: description, strategy: strategy}
+
+	return id, nil
+}
+
+func (s *Storage) CreateSocialDemo(id string, description string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.socialDemos[id]; ok {
+		return "", fmt.Errorf("cannot insert social demo, %w", storage.ErrAlreadyExists)
+	}
+
+	s.socialDemos[id] = description
+
+	return id, nil
+}
+
+func toSet(items []string) map[string]bool {
+	set := make(map[string]bool, len(items))
+
+	for _, item := range items {
+		set[item] = true
+	}
+
+	return set
+}
+
+func (s *Storage) GetBannerItem(id string) (sqlstorage.BannerItem, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	item, ok := s.banners[id]
+	if !ok {
+		return sqlstorage.BannerItem{}, fmt.Errorf("cannot get banner, %w", storage.ErrNotFound)
+	}
+
+	return item.item(id), nil
+}
+
+func (s *Storage) ListBanners(afterID string, limit int) ([]sqlstorage.BannerItem, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := make([]string, 0, len(s.banners))
+
+	for id := range s.banners {
+		ids = append(ids, id)
+	}
+
+	ids = pageIDs(ids, afterID, limit)
+	banners := make([]sqlstorage.BannerItem, 0, len(ids))
+
+	for _, id := range ids {
+		banners = append(banners, s.banners[id].item(id))
+	}
+
+	return banners, nil
+}
+
+// UpdateBanner changes fields which are not nil, other fields are kept.
+func (s *Storage) UpdateBanner(id string, description *string, targetURL *string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.banners[id]
+	if !ok {
+		return fmt.Errorf("cannot update banner, %w", storage.ErrNotFound)
+	}
+
+	if description != nil {
+		item.description = *description
+	}
+
+	if targetURL != nil {
+		item.targetURL = *targetURL
+	}
+
+	s.banners[id] = item
+
+	return nil
+}
+
+// DeleteBanner removes banner with its rotations and statistics, events are kept.
+func (s *Storage) DeleteBanner(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.banners[id]; !ok {
+		return fmt.Errorf("cannot delete banner, %w", storage.ErrNotFound)
+	}
+
+	delete(s.banners, id)
+	s.deleteReferences(func(slotID string, bannerID string, socialDemoID string) bool {
+		return bannerID == id
+	})
+
+	return nil
+}
+
+func (s *Storage) GetSlotItem(id string) (sqlstorage.SlotItem, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	slot, ok := s.slots[id]
+	if !ok {
+		return sqlstorage.SlotItem{}, fmt.Errorf("cannot get slot, %w", storage.ErrNotFound)
+	}
+
+	return sqlstorage.SlotItem{ID: id, Description: slot.description, Strategy: slot.strategy}, nil
+}
+
+func (s *Storage) ListSlots(afterID string, limit int) ([]sqlstorage.SlotItem, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := make([]string, 0, len(s.slots))
+
+	for id := range s.slots {
+		ids = append(ids, id)
+	}
+
+	ids = pageIDs(ids, afterID, limit)
+	slots := make([]sqlstorage.SlotItem, 0, len(ids))
+
+	for _, id := range ids {
+		slots = append(slots, sqlstorage.SlotItem{ID: id, Description: s.slots[id].description, Strategy: s.slots[id].strategy})
+	}
+
+	return slots, nil
+}
+
+// UpdateSlot changes fields which are not nil, other fields are kept.
+func (s *Storage) UpdateSlot(id string, description *string, strategy *string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.slots[id]
+	if !ok {
+		return fmt.Errorf("cannot update slot, %w", storage.ErrNotFound)
+	}
+
+	if description != nil {
+		item.description = *description
+	}
+
+	if strategy != nil {
+		item.strategy = *strategy
+	}
+
+	s.slots[id] = item
+
+	return nil
+}
+
+// DeleteSlot removes slot with its rotations, statistics and bandit models, events are kept.
+func (s *Storage) DeleteSlot(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.slots[id]; !ok {
+		return fmt.Errorf("cannot delete slot, %w", storage.ErrNotFound)
+	}
+
+	delete(s.slots, id)
+	s.deleteReferences(func(slotID string, bannerID string, socialDemoID string) bool {
+		return slotID == id
+	})
+
+	for key := range s.models {
+		if key.slotID == id {
+			delete(s.models, key)
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) GetSocialDemoItem(id string) (sqlstorage.SocialDemoItem, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	description, ok := s.socialDemos[id]
+	if !ok {
+		return sqlstorage.SocialDemoItem{}, fmt.Errorf("cannot get social demo, %w", storage.ErrNotFound)
+	}
+
+	return sqlstorage.SocialDemoItem{ID: id, Description: description}, nil
+}
+
+func (s *Storage) ListSocialDemos(afterID string, limit int) ([]sqlstorage.SocialDemoItem, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := make([]string, 0, len(s.socialDemos))
+
+	for id := range s.socialDemos {
+		ids = append(ids, id)
+	}
+
+	ids = pageIDs(ids, afterID, limit)
+	socialDemos := make([]sqlstorage.SocialDemoItem, 0, len(ids))
+
+	for _, id := range ids {
+		socialDemos = append(socialDemos, sqlstorage.SocialDemoItem{ID: id, Description: s.socialDemos[id]})
+	}
+
+	return socialDemos, nil
+}
+
+func (s *Storage) UpdateSocialDemo(id string, description string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.socialDemos[id]; !ok {
+		return fmt.Errorf("cannot update social demo, %w", storage.ErrNotFound)
+	}
+
+	s.socialDemos[id] = description
+
+	return nil
+}
+
+// DeleteSocialDemo removes social demo with its statistics, events are kept.
+func (s *Storage) DeleteSocialDemo(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.socialDemos[id]; !ok {
+		return fmt.Errorf("cannot delete social demo, %w", storage.ErrNotFound)
+	}
+
+	delete(s.socialDemos, id)
+	s.deleteReferences(func(slotID string, bannerID string, socialDemoID string) bool {
+		return socialDemoID == id
+	})
+
+	return nil
+}
+
+// GetSlotRotation returns banners in rotation of slot ordered by id.
+func (s *Storage) GetSlotRotation(slotID string) ([]sqlstorage.BannerItem, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	banners := []sqlstorage.BannerItem{}
+
+	for _, item := range s.rotations {
+		if item.SlotID == slotID {
+			banners = append(banners, s.banners[item.BannerID].item(item.BannerID))
+		}
+	}
+
+	sort.Slice(banners, func(i, j int) bool { return banners[i].ID < banners[j].ID })
+
+	return banners, nil
+}
+
+// GetBannerSlots returns slots which rotate banner ordered by id.
+func (s *Storage) GetBannerSlots(bannerID string) ([]sqlstorage.SlotItem, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	slots := []sqlstorage.SlotItem{}
+
+	for _, item := range s.rotations {
+		if item.BannerID == bannerID {
+			slot := s.slots[item.SlotID]
+			slots = append(slots, sqlstorage.SlotItem{ID: item.SlotID, Description: slot.description, Strategy: slot.strategy})
+		}
+	}
+
+	sort.Slice(slots, func(i, j int) bool { return slots[i].ID < slots[j].ID })
+
+	return slots, nil
+}
+
+// deleteReferences works like cascade delete of sql storage, rotations have empty socialDemoID.
+func (s *Storage) deleteReferences(match func(slotID string, bannerID string, socialDemoID string) bool) {
+	rotations := s.rotations[:0]
+
+	for _, item := range s.rotations {
+		if !match(item.SlotID, item.BannerID, "") {
+			rotations = append(rotations, item)
+		}
+	}
+
+	s.rotations = rotations
+
+	for key := range s.stats {
+		if match(key.slotID, key.bannerID, key.socialDemoID) {
+			delete(s.stats, key)
+		}
+	}
+}
+
+// pageIDs returns up to limit sorted ids greater than afterID.
+func pageIDs(ids []string, afterID string, limit int) []string {
+	sort.Strings(ids)
+
+	start := sort.Search(len(ids), func(i int) bool { return ids[i] > afterID })
+	ids = ids[start:]
+
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	return ids
+}
+
+func (s *Storage) AddEventRollup(
+	eventID int64,
+	eventType string,
+	bannerID string,
+	slotID string,
+	socialDemoID string,
+	date time.Time,
+) error {
+	if eventType != sqlstorage.EventView && eventType != sqlstorage.EventClick {
+		return fmt.Errorf("cannot add event rollup, %q, %w", eventType, sqlstorage.ErrUnknownEventType)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if eventID > 0 {
+		if _, ok := s.processedEvents[eventID]; ok {
+			return nil
+		}
+
+		s.processedEvents[eventID] = time.Now()
+	}
+
+	hour := sqlstorage.BucketStart(date, sqlstorage.BucketHour)
+	key := rollupKey{statsKey{slotID, bannerID, socialDemoID}, hour}
+
+	row, ok := s.rollups[key]
+	if !ok {
+		row = &sqlstorage.RollupRow{SlotID: slotID, BannerID: bannerID, SocialDemoID: socialDemoID, Hour: hour}
+		s.rollups[key] = row
+	}
+
+	if eventType == sqlstorage.EventView {
+		row.Views++
+	} else {
+		row.Clicks++
+	}
+
+	return nil
+}
+
+func (s *Storage) PruneProcessedEvents(before time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var pruned int64
+
+	for id, processedAt := range s.processedEvents {
+		if processedAt.Before(before) {
+			delete(s.processedEvents, id)
+			pruned++
+		}
+	}
+
+	return pruned, nil
+}
+
+func (s *Storage) GetEventRollups(from time.Time, to time.Time) ([]sqlstorage.RollupRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rows := make([]sqlstorage.RollupRow, 0)
+
+	for _, row := range s.rollups {
+		if !row.Hour.Before(from) && row.Hour.Before(to) {
+			rows = append(rows, *row)
+		}
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+
+		if !a.Hour.Equal(b.Hour) {
+			return a.Hour.Before(b.Hour)
+		}
+
+		if a.SlotID != b.SlotID {
+			return a.SlotID < b.SlotID
+		}
+
+		if a.BannerID != b.BannerID {
+			return a.BannerID < b.BannerID
+		}
+
+		return a.SocialDemoID < b.SocialDemoID
+	})
+
+	return rows, nil
+}
+
+// outboxItem is pending event with claim of relay like in sql storage.
+type outboxItem struct {
+	row          sqlstorage.OutboxRow
+	claimedUntil time.Time
+}
+
+func (s *Storage) addOutbox(eventType string, bannerID string, slotID string, socialDemoID string, date time.Time) {
+	s.outboxID++
+	s.outbox = append(s.outbox, outboxItem{row: sqlstorage.OutboxRow{
+		ID: s.outboxID, Type: eventType, SlotID: slotID, BannerID: bannerID, SocialDemoID: socialDemoID, Date: date,
+	}})
+}
+
+// PublishOutbox claims events and publishes them without lock, then removes published ones and releases the rest.
+func (s *Storage) PublishOutbox(
+	limit int,
+	date time.Time,
+	claim time.Duration,
+	publish func(row sqlstorage.OutboxRow) error,
+) (int, error) {
+	rows := s.claimOutbox(limit, date, claim)
+
+	var (
+		sent int
+		err  error
+	)
+
+	for ; sent < len(rows); sent++ {
+		if err = publish(rows[sent]); err != nil {
+			break
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	published := make(map[int64]bool, sent)
+
+	for _, row := range rows[:sent] {
+		published[row.ID] = true
+	}
+
+	outbox := s.outbox[:0]
+
+	for _, item := range s.outbox {
+		if published[item.row.ID] {
+			continue
+		}
+
+		for _, row := range rows[sent:] {
+			if row.ID == item.row.ID {
+				item.claimedUntil = time.Time{}
+			}
+		}
+
+		outbox = append(outbox, item)
+	}
+
+	s.outbox = outbox
+
+	return sent, err
+}
+
+func (s *Storage) claimOutbox(limit int, date time.Time, claim time.Duration) []sqlstorage.OutboxRow {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rows := make([]sqlstorage.OutboxRow, 0, limit)
+
+	for i := range s.outbox {
+		if len(rows) == limit {
+			break
+		}
+
+		if claimed := s.outbox[i].claimedUntil; !claimed.IsZero() && !claimed.Before(date) {
+			continue
+		}
+
+		s.outbox[i].claimedUntil = date.Add(claim)
+		rows = append(rows, s.outbox[i].row)
+	}
+
+	return rows
+}
@@ -0,0 +1,365 @@
+package memorystorage
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Fuchsoria/banners-rotation/internal/storage"
+	sqlstorage "github.com/Fuchsoria/banners-rotation/internal/storage/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage(t *testing.T) {
+	date := time.Date(2021, 8, 1, 12, 30, 0, 0, time.Local)
+
+	t.Run("test create items", func(t *testing.T) {
+		s := New()
+
+		id, err := s.CreateBanner("banner1", "", "")
+		require.NoError(t, err)
+		require.Equal(t, "banner1", id)
+
+		_, err = s.CreateBanner("banner1", "", "")
+		require.ErrorIs(t, err, storage.ErrAlreadyExists)
+
+		_, err = s.CreateSlot("slot1", "", "thompson")
+		require.NoError(t, err)
+
+		strategy, err := s.GetSlotStrategy("slot1")
+		require.NoError(t, err)
+		require.Equal(t, "thompson", strategy)
+
+		strategy, err = s.GetSlotStrategy("slot2")
+		require.NoError(t, err)
+		require.Empty(t, strategy)
+
+		_, err = s.CreateSocialDemo("social_demo1", "")
+		require.NoError(t, err)
+
+		_, err = s.CreateSocialDemo("social_demo1", "")
+		require.ErrorIs(t, err, storage.ErrAlreadyExists)
+	})
+
+	t.Run("test rotation", func(t *testing.T) {
+		s := newTestStorage(t)
+
+		require.NoError(t, s.AddBannerRotation("banner1", "slot1"))
+		require.NoError(t, s.AddBannerRotation("banner2", "slot1"))
+		require.NoError(t, s.AddBannerRotation("banner1", "slot2"))
+
+		bannersInSlot, err := s.GetBannersInSlot("slot1")
+		require.NoError(t, err)
+		require.Len(t, bannersInSlot, 2)
+
+		require.NoError(t, s.RemoveBannerRotation("banner1", "slot1"))
+		require.ErrorIs(t, s.RemoveBannerRotation("banner1", "slot1"), sqlstorage.ErrBannersWereRemoved)
+
+		bannersInSlots, err := s.GetBannersInSlots([]string{"slot1", "slot2"})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []sqlstorage.BannerRotationItem{
+			{SlotID: "slot1", BannerID: "banner2"},
+			{SlotID: "slot2", BannerID: "banner1"},
+		}, bannersInSlots)
+	})
+
+	t.Run("test references", func(t *testing.T) {
+		s := newTestStorage(t)
+
+		require.ErrorIs(t, s.AddBannerRotation("banner3", "slot1"), storage.ErrNotFound)
+		require.ErrorIs(t, s.AddBannerRotation("banner1", "slot3"), storage.ErrNotFound)
+
+		require.NoError(t, s.AddBannerRotation("banner1", "slot1"))
+		require.ErrorIs(t, s.AddBannerRotation("banner1", "slot1"), storage.ErrAlreadyExists)
+
+		require.ErrorIs(t, s.AddViewEvent("banner1", "slot1", "social_demo3", date), storage.ErrNotFound)
+		require.ErrorIs(t, s.AddClickEvent("banner1", "slot3", "social_demo1", date), storage.ErrNotFound)
+		require.ErrorIs(t, s.UpdateBanditModel("slot3", "linucb", replaceModel([]byte(`{}`))), storage.ErrNotFound)
+	})
+
+	t.Run("test cascade delete", func(t *testing.T) {
+		s := newTestStorage(t)
+
+		require.NoError(t, s.AddBannerRotation("banner1", "slot1"))
+		require.NoError(t, s.AddBannerRotation("banner2", "slot1"))
+		require.NoError(t, s.AddViewEvent("banner1", "slot1", "social_demo1", date))
+		require.NoError(t, s.AddClickEvent("banner2", "slot1", "social_demo2", date))
+		require.NoError(t, s.UpdateBanditModel("slot1", "linucb", replaceModel([]byte(`{}`))))
+
+		require.NoError(t, s.DeleteBanner("banner1"))
+
+		bannersInSlot, err := s.GetBannersInSlot("slot1")
+		require.NoError(t, err)
+		require.Equal(t, []sqlstorage.BannerRotationItem{{SlotID: "slot1", BannerID: "banner2"}}, bannersInSlot)
+
+		countViews := func() int {
+			views := 0
+			err := s.StreamEvents(sqlstorage.StatsQuery{SlotID: "slot1", BannerID: "banner1"}, func(row sqlstorage.EventRow) error {
+				views++
+
+				return nil
+			})
+			require.NoError(t, err)
+
+			return views
+		}
+
+		require.Len(t, s.views, 1, "events should be kept")
+		require.Zero(t, countViews(), "events of deleted banner should not be read")
+
+		rows, err := s.GetStats(sqlstorage.StatsQuery{GroupBy: []string{sqlstorage.StatsByBanner}})
+		require.NoError(t, err)
+		require.Equal(t, []sqlstorage.StatsRow{{BannerID: "banner2", Clicks: 1}}, rows)
+
+		timeStats, err := s.GetBannersTimeStats("slot1", date)
+		require.NoError(t, err)
+		require.Len(t, timeStats, 1)
+		require.Equal(t, "banner2", timeStats[0].BannerID)
+
+		backfilled, err := s.BackfillBannerStats()
+		require.NoError(t, err)
+		require.Equal(t, int64(1), backfilled, "backfill should skip events of deleted banner")
+
+		_, err = s.CreateBanner("banner1", "", "")
+		require.NoError(t, err)
+		require.Equal(t, 1, countViews(), "banner created with id of deleted one should get its events")
+
+		require.NoError(t, s.DeleteBanner("banner1"))
+
+		require.NoError(t, s.DeleteSocialDemo("social_demo2"))
+
+		stats, err := s.GetSlotStats("slot1")
+		require.NoError(t, err)
+		require.Empty(t, stats)
+
+		require.NoError(t, s.DeleteSlot("slot1"))
+		require.ErrorIs(t, s.DeleteSlot("slot1"), storage.ErrNotFound)
+
+		model, err := s.GetBanditModel("slot1", "linucb")
+		require.NoError(t, err)
+		require.Nil(t, model)
+
+		bannersInSlot, err = s.GetBannersInSlot("slot1")
+		require.NoError(t, err)
+		require.Empty(t, bannersInSlot)
+	})
+
+	t.Run("test events and stats", func(t *testing.T) {
+		s := newTestStorage(t)
+
+		require.NoError(t, s.AddBannerRotation("banner1", "slot1"))
+		require.NoError(t, s.AddBannerRotation("banner2", "slot1"))
+		require.NoError(t, s.AddViewEvent("banner1", "slot1", "social_demo1", date))
+		require.NoError(t, s.AddViewEvent("banner1", "slot1", "social_demo1", date))
+		require.NoError(t, s.AddClickEvent("banner1", "slot1", "social_demo1", date))
+
+		stats, err := s.GetSlotStats("slot1")
+		require.NoError(t, err)
+		require.Equal(t, []sqlstorage.StatsItem{
+			{SlotID: "slot1", BannerID: "banner1", SocialDemoID: "social_demo1", Views: 2, Clicks: 1},
+		}, stats)
+
+		rows, err := s.BackfillBannerStats()
+		require.NoError(t, err)
+		require.Equal(t, int64(1), rows)
+
+		rebuilt, err := s.GetSlotsStats([]string{"slot1"})
+		require.NoError(t, err)
+		require.Equal(t, stats, rebuilt)
+	})
+
+	t.Run("test time stats", func(t *testing.T) {
+		s := newTestStorage(t)
+		hour := time.Date(2021, 8, 1, 12, 0, 0, 0, time.Local)
+
+		require.NoError(t, s.AddViewEvent("banner1", "slot1", "social_demo1", date))
+		require.NoError(t, s.AddViewEvent("banner1", "slot1", "social_demo2", date))
+		require.NoError(t, s.AddClickEvent("banner1", "slot1", "social_demo2", date))
+		require.NoError(t, s.AddViewEvent("banner1", "slot1", "social_demo1", hour.Add(-48*time.Hour)))
+
+		stats, err := s.GetBannersTimeStats("slot1", hour.Add(-time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, []sqlstorage.TimeStatsItem{{BannerID: "banner1", Hour: hour, Views: 2, Clicks: 1}}, stats)
+
+		stats, err = s.GetBannersTimeStatsBySocialDemo("slot1", "social_demo1", time.Time{})
+		require.NoError(t, err)
+		require.Len(t, stats, 2)
+		require.Equal(t, 1, stats[1].Views)
+		require.Equal(t, 0, stats[1].Clicks)
+	})
+
+	t.Run("test stats query", func(t *testing.T) {
+		s := newTestStorage(t)
+		sunday := time.Date(2021, 8, 1, 12, 30, 0, 0, time.UTC)
+		monday := sunday.Add(24 * time.Hour)
+
+		require.NoError(t, s.AddViewEvent("banner1", "slot1", "social_demo1", sunday))
+		require.NoError(t, s.AddViewEvent("banner2", "slot1", "social_demo2", sunday))
+		require.NoError(t, s.AddClickEvent("banner2", "slot1", "social_demo2", sunday))
+		require.NoError(t, s.AddViewEvent("banner1", "slot2", "social_demo1", monday))
+
+		rows, err := s.GetStats(sqlstorage.StatsQuery{})
+		require.NoError(t, err)
+		require.Equal(t, []sqlstorage.StatsRow{{Views: 3, Clicks: 1}}, rows)
+
+		rows, err = s.GetStats(sqlstorage.StatsQuery{GroupBy: []string{sqlstorage.StatsByBanner}, SlotID: "slot1"})
+		require.NoError(t, err)
+		require.Equal(t, []sqlstorage.StatsRow{{BannerID: "banner1", Views: 1}, {BannerID: "banner2", Views: 1, Clicks: 1}}, rows)
+
+		rows, err = s.GetStats(sqlstorage.StatsQuery{Bucket: sqlstorage.BucketWeek})
+		require.NoError(t, err)
+		require.Len(t, rows, 2, "sunday and monday are in different weeks")
+		require.Equal(t, time.Date(2021, 7, 26, 0, 0, 0, 0, time.UTC), *rows[0].Time)
+		require.Equal(t, monday.Truncate(24*time.Hour), *rows[1].Time)
+
+		rows, err = s.GetStats(sqlstorage.StatsQuery{Bucket: sqlstorage.BucketHour, From: sunday.Add(time.Hour)})
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, 1, rows[0].Views)
+
+		events := []sqlstorage.EventRow{}
+		err = s.StreamEvents(sqlstorage.StatsQuery{BannerID: "banner1"}, func(row sqlstorage.EventRow) error {
+			events = append(events, row)
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []sqlstorage.EventRow{
+			{Type: sqlstorage.EventView, SlotID: "slot1", BannerID: "banner1", SocialDemoID: "social_demo1", Date: sunday},
+			{Type: sqlstorage.EventView, SlotID: "slot2", BannerID: "banner1", SocialDemoID: "social_demo1", Date: monday},
+		}, events)
+
+		rows, err = s.GetStats(sqlstorage.StatsQuery{From: monday.Add(time.Hour)})
+		require.NoError(t, err)
+		require.Equal(t, []sqlstorage.StatsRow{{}}, rows, "aggregation without groups has a row")
+
+		_, err = s.GetStats(sqlstorage.StatsQuery{GroupBy: []string{"date"}})
+		require.ErrorIs(t, err, sqlstorage.ErrInvalidStatsQuery)
+
+		_, err = s.GetStats(sqlstorage.StatsQuery{From: monday, To: sunday})
+		require.ErrorIs(t, err, sqlstorage.ErrInvalidStatsQuery)
+	})
+
+	t.Run("test bandit model", func(t *testing.T) {
+		s := newTestStorage(t)
+
+		model, err := s.GetBanditModel("slot1", "linucb")
+		require.NoError(t, err)
+		require.Nil(t, model)
+
+		require.NoError(t, s.UpdateBanditModel("slot1", "linucb", replaceModel([]byte(`{}`))))
+
+		model, err = s.GetBanditModel("slot1", "linucb")
+		require.NoError(t, err)
+		require.Equal(t, []byte(`{}`), model)
+
+		err = s.UpdateBanditModel("slot1", "linucb", func(model []byte) ([]byte, error) {
+			require.Equal(t, []byte(`{}`), model, "update should get saved model")
+
+			return nil, errors.New("cannot update")
+		})
+		require.Error(t, err)
+
+		model, err = s.GetBanditModel("slot1", "linucb")
+		require.NoError(t, err)
+		require.Equal(t, []byte(`{}`), model, "model should be kept when update fails")
+	})
+
+	t.Run("test outbox claims", func(t *testing.T) {
+		s := newTestStorage(t)
+		errPublish := errors.New("publish failed")
+
+		require.NoError(t, s.AddViewEvent("banner1", "slot1", "social_demo1", date))
+		require.NoError(t, s.AddClickEvent("banner1", "slot1", "social_demo1", date))
+
+		ids := []int64{}
+		sent, err := s.PublishOutbox(10, date, time.Minute, func(row sqlstorage.OutboxRow) error {
+			other, err := s.PublishOutbox(10, date, time.Minute, func(row sqlstorage.OutboxRow) error { return nil })
+			require.NoError(t, err)
+			require.Zero(t, other, "claimed events should not be taken by other relay")
+
+			if row.Type == sqlstorage.EventClick {
+				return errPublish
+			}
+
+			ids = append(ids, row.ID)
+
+			return nil
+		})
+		require.ErrorIs(t, err, errPublish)
+		require.Equal(t, 1, sent)
+		require.Equal(t, []int64{1}, ids)
+
+		sent, err = s.PublishOutbox(10, date, time.Minute, func(row sqlstorage.OutboxRow) error {
+			ids = append(ids, row.ID)
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, sent, "failed event should be released")
+		require.Equal(t, []int64{1, 2}, ids)
+
+		require.NoError(t, s.AddViewEvent("banner1", "slot1", "social_demo1", date))
+		s.claimOutbox(10, date, time.Minute)
+
+		sent, err = s.PublishOutbox(10, date.Add(time.Minute), time.Minute, func(row sqlstorage.OutboxRow) error { return nil })
+		require.NoError(t, err)
+		require.Zero(t, sent, "claim should not expire before its end")
+
+		sent, err = s.PublishOutbox(10, date.Add(time.Minute+time.Second), time.Minute, func(row sqlstorage.OutboxRow) error { return nil })
+		require.NoError(t, err)
+		require.Equal(t, 1, sent, "expired claim should be taken again")
+	})
+
+	t.Run("test concurrent events", func(t *testing.T) {
+		s := newTestStorage(t)
+		wg := sync.WaitGroup{}
+
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				_ = s.AddViewEvent("banner1", "slot1", "social_demo1", date)
+				_, _ = s.GetSlotStats("slot1")
+			}()
+		}
+
+		wg.Wait()
+
+		stats, err := s.GetSlotStats("slot1")
+		require.NoError(t, err)
+		require.Equal(t, 100, stats[0].Views)
+	})
+}
+
+func newTestStorage(t *testing.T) *Storage {
+	t.Helper()
+
+	s := New()
+
+	for _, id := range []string{"slot1", "slot2"} {
+		_, err := s.CreateSlot(id, "", "")
+		require.NoError(t, err)
+	}
+
+	for _, id := range []string{"banner1", "banner2"} {
+		_, err := s.CreateBanner(id, "", "")
+		require.NoError(t, err)
+	}
+
+	for _, id := range []string{"social_demo1", "social_demo2"} {
+		_, err := s.CreateSocialDemo(id, "")
+		require.NoError(t, err)
+	}
+
+	return s
+}
+
+func replaceModel(model []byte) func([]byte) ([]byte, error) {
+	return func([]byte) ([]byte, error) {
+		return model, nil
+	}
+}
@@ -0,0 +1,236 @@
+package sqlstorage
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationsLockID is a key of postgres advisory lock, so several instances
+// started with auto migration don't apply the same migration twice.
+const migrationsLockID = 7295728
+
+var (
+	ErrInvalidMigration = errors.New("invalid migration file")
+	ErrNoMigrations     = errors.New("there are no applied migrations")
+)
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+type MigrationStatus struct {
+	Version   int
+	Name      string
+	AppliedAt *time.Time
+}
+
+type appliedMigration struct {
+	Version   int       `db:"version"`
+	AppliedAt time.Time `db:"applied_at"`
+}
+
+// LoadMigrations returns embedded migrations sorted by version,
+// files are named as <version>_<name>.up.sql and <version>_<name>.down.sql.
+func LoadMigrations() ([]Migration, error) {
+	entries, err := fs.ReadDir(migrationFiles, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("cannot read migrations, %w", err)
+	}
+
+	migrations := map[int]*Migration{}
+
+	for _, entry := range entries {
+		fileName := entry.Name()
+		base := strings.TrimSuffix(fileName, ".sql")
+		direction := path.Ext(base)
+		base = strings.TrimSuffix(base, direction)
+
+		parts := strings.SplitN(base, "_", 2)
+		if len(parts) != 2 || (direction != ".up" && direction != ".down") {
+			return nil, fmt.Errorf("%s, %w", fileName, ErrInvalidMigration)
+		}
+
+		version, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s, %w", fileName, ErrInvalidMigration)
+		}
+
+		content, err := migrationFiles.ReadFile(path.Join("migrations", fileName))
+		if err != nil {
+			return nil, fmt.Errorf("cannot read migration %s, %w", fileName, err)
+		}
+
+		migration, ok := migrations[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: parts[1]}
+			migrations[version] = migration
+		}
+
+		if migration.Name != parts[1] {
+			return nil, fmt.Errorf("%s has different name than version %d, %w", fileName, version, ErrInvalidMigration)
+		}
+
+		if direction == ".up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	result := make([]Migration, 0, len(migrations))
+
+	for _, migration := range migrations {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("version %d should have up and down files, %w", migration.Version, ErrInvalidMigration)
+		}
+
+		result = append(result, *migration)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+
+	return result, nil
+}
+
+// MigrateUp applies all pending migrations in a single transaction
+// and returns the list of applied ones.
+func (s *Storage) MigrateUp() (applied []Migration, err error) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.inMigrationTx(func(tx *sqlx.Tx, done map[int]time.Time) error {
+		for _, migration := range migrations {
+			if _, ok := done[migration.Version]; ok {
+				continue
+			}
+
+			if _, err := tx.Exec(migration.Up); err != nil {
+				return fmt.Errorf("cannot apply migration %d_%s, %w", migration.Version, migration.Name, err)
+			}
+
+			if _, err := tx.Exec("INSERT INTO schema_migrations (version) VALUES ($1)", migration.Version); err != nil {
+				return err
+			}
+
+			applied = append(applied, migration)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot migrate up, %w", err)
+	}
+
+	return applied, nil
+}
+
+// MigrateDown rolls back the last applied migration.
+func (s *Storage) MigrateDown() (reverted Migration, err error) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return Migration{}, err
+	}
+
+	err = s.inMigrationTx(func(tx *sqlx.Tx, done map[int]time.Time) error {
+		for i := len(migrations) - 1; i >= 0; i-- {
+			if _, ok := done[migrations[i].Version]; !ok {
+				continue
+			}
+
+			reverted = migrations[i]
+
+			if _, err := tx.Exec(reverted.Down); err != nil {
+				return fmt.Errorf("cannot revert migration %d_%s, %w", reverted.Version, reverted.Name, err)
+			}
+
+			_, err := tx.Exec("DELETE FROM schema_migrations WHERE version=$1", reverted.Version)
+
+			return err
+		}
+
+		return ErrNoMigrations
+	})
+	if err != nil {
+		return Migration{}, fmt.Errorf("cannot migrate down, %w", err)
+	}
+
+	return reverted, nil
+}
+
+// MigrationsStatus returns all known migrations with time when they were applied.
+func (s *Storage) MigrationsStatus() ([]MigrationStatus, error) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(migrations))
+
+	err = s.inMigrationTx(func(tx *sqlx.Tx, done map[int]time.Time) error {
+		for _, migration := range migrations {
+			status := MigrationStatus{Version: migration.Version, Name: migration.Name}
+
+			if appliedAt, ok := done[migration.Version]; ok {
+				status.AppliedAt = &appliedAt
+			}
+
+			statuses = append(statuses, status)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get migrations status, %w", err)
+	}
+
+	return statuses, nil
+}
+
+// inMigrationTx locks migrations for other instances, ensures schema_migrations
+// table exists and passes applied versions to fn.
+func (s *Storage) inMigrationTx(fn func(tx *sqlx.Tx, done map[int]time.Time) error) error {
+	return s.inTx(func(tx *sqlx.Tx) error {
+		if _, err := tx.Exec("SELECT pg_advisory_xact_lock($1)", migrationsLockID); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER NOT NULL PRIMARY KEY,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`)
+		if err != nil {
+			return err
+		}
+
+		var applied []appliedMigration
+
+		if err := tx.Select(&applied, "SELECT version,applied_at FROM schema_migrations"); err != nil {
+			return err
+		}
+
+		done := make(map[int]time.Time, len(applied))
+
+		for _, migration := range applied {
+			done[migration.Version] = migration.AppliedAt
+		}
+
+		return fn(tx, done)
+	})
+}
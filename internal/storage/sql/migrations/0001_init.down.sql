@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS "views";
+DROP TABLE IF EXISTS "clicks";
+DROP TABLE IF EXISTS "banners_rotation";
+DROP TABLE IF EXISTS "social_demos";
+DROP TABLE IF EXISTS "banners";
+DROP TABLE IF EXISTS "slots";
@@ -0,0 +1 @@
+ALTER TABLE "slots" ADD COLUMN IF NOT EXISTS "strategy" TEXT DEFAULT '';
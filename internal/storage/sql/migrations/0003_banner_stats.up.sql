@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS "banner_stats" (
+	"slot_id" TEXT NOT NULL,
+	"banner_id" TEXT NOT NULL,
+	"social_demo_id" TEXT NOT NULL,
+	"views" BIGINT NOT NULL DEFAULT 0,
+	"clicks" BIGINT NOT NULL DEFAULT 0,
+	PRIMARY KEY ("slot_id", "banner_id", "social_demo_id")
+);
+
+INSERT INTO "banner_stats" ("slot_id", "banner_id", "social_demo_id", "views", "clicks")
+	SELECT slot_id, banner_id, social_demo_id, SUM(views), SUM(clicks) FROM (
+		SELECT slot_id, banner_id, social_demo_id, 1 AS views, 0 AS clicks FROM "views"
+		UNION ALL
+		SELECT slot_id, banner_id, social_demo_id, 0 AS views, 1 AS clicks FROM "clicks"
+	) events GROUP BY slot_id, banner_id, social_demo_id
+	ON CONFLICT DO NOTHING;
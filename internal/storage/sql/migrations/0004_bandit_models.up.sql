@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS "bandit_models" (
+	"slot_id" TEXT NOT NULL,
+	"strategy" TEXT NOT NULL,
+	"model" JSONB NOT NULL,
+	PRIMARY KEY ("slot_id", "strategy")
+);
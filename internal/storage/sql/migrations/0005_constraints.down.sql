@@ -0,0 +1,24 @@
+ALTER TABLE "bandit_models"
+	DROP CONSTRAINT IF EXISTS "bandit_models_slot_id_fkey";
+
+ALTER TABLE "banner_stats"
+	DROP CONSTRAINT IF EXISTS "banner_stats_slot_id_fkey",
+	DROP CONSTRAINT IF EXISTS "banner_stats_banner_id_fkey",
+	DROP CONSTRAINT IF EXISTS "banner_stats_social_demo_id_fkey";
+
+ALTER TABLE "views"
+	DROP CONSTRAINT IF EXISTS "views_slot_id_fkey",
+	DROP CONSTRAINT IF EXISTS "views_banner_id_fkey",
+	DROP CONSTRAINT IF EXISTS "views_social_demo_id_fkey",
+	DROP COLUMN IF EXISTS "id";
+
+ALTER TABLE "clicks"
+	DROP CONSTRAINT IF EXISTS "clicks_slot_id_fkey",
+	DROP CONSTRAINT IF EXISTS "clicks_banner_id_fkey",
+	DROP CONSTRAINT IF EXISTS "clicks_social_demo_id_fkey",
+	DROP COLUMN IF EXISTS "id";
+
+ALTER TABLE "banners_rotation"
+	DROP CONSTRAINT IF EXISTS "banners_rotation_slot_id_fkey",
+	DROP CONSTRAINT IF EXISTS "banners_rotation_banner_id_fkey",
+	DROP CONSTRAINT IF EXISTS "banners_rotation_pkey";
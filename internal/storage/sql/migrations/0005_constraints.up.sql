@@ -0,0 +1,42 @@
+-- Items referenced by existing rows are created with empty description, so constraints can be added without data loss.
+INSERT INTO "slots" ("id")
+	SELECT slot_id FROM "banners_rotation" UNION SELECT slot_id FROM "clicks" UNION SELECT slot_id FROM "views"
+	UNION SELECT slot_id FROM "banner_stats" UNION SELECT slot_id FROM "bandit_models"
+	ON CONFLICT DO NOTHING;
+
+INSERT INTO "banners" ("id")
+	SELECT banner_id FROM "banners_rotation" UNION SELECT banner_id FROM "clicks" UNION SELECT banner_id FROM "views"
+	UNION SELECT banner_id FROM "banner_stats"
+	ON CONFLICT DO NOTHING;
+
+INSERT INTO "social_demos" ("id")
+	SELECT social_demo_id FROM "clicks" UNION SELECT social_demo_id FROM "views" UNION SELECT social_demo_id FROM "banner_stats"
+	ON CONFLICT DO NOTHING;
+
+DELETE FROM "banners_rotation" a USING "banners_rotation" b
+	WHERE a.ctid > b.ctid AND a.slot_id = b.slot_id AND a.banner_id = b.banner_id;
+
+ALTER TABLE "banners_rotation"
+	ADD PRIMARY KEY ("slot_id", "banner_id"),
+	ADD CONSTRAINT "banners_rotation_slot_id_fkey" FOREIGN KEY ("slot_id") REFERENCES "slots" ("id") ON DELETE CASCADE,
+	ADD CONSTRAINT "banners_rotation_banner_id_fkey" FOREIGN KEY ("banner_id") REFERENCES "banners" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "clicks"
+	ADD COLUMN "id" BIGSERIAL PRIMARY KEY,
+	ADD CONSTRAINT "clicks_slot_id_fkey" FOREIGN KEY ("slot_id") REFERENCES "slots" ("id") ON DELETE CASCADE,
+	ADD CONSTRAINT "clicks_banner_id_fkey" FOREIGN KEY ("banner_id") REFERENCES "banners" ("id") ON DELETE CASCADE,
+	ADD CONSTRAINT "clicks_social_demo_id_fkey" FOREIGN KEY ("social_demo_id") REFERENCES "social_demos" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "views"
+	ADD COLUMN "id" BIGSERIAL PRIMARY KEY,
+	ADD CONSTRAINT "views_slot_id_fkey" FOREIGN KEY ("slot_id") REFERENCES "slots" ("id") ON DELETE CASCADE,
+	ADD CONSTRAINT "views_banner_id_fkey" FOREIGN KEY ("banner_id") REFERENCES "banners" ("id") ON DELETE CASCADE,
+	ADD CONSTRAINT "views_social_demo_id_fkey" FOREIGN KEY ("social_demo_id") REFERENCES "social_demos" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "banner_stats"
+	ADD CONSTRAINT "banner_stats_slot_id_fkey" FOREIGN KEY ("slot_id") REFERENCES "slots" ("id") ON DELETE CASCADE,
+	ADD CONSTRAINT "banner_stats_banner_id_fkey" FOREIGN KEY ("banner_id") REFERENCES "banners" ("id") ON DELETE CASCADE,
+	ADD CONSTRAINT "banner_stats_social_demo_id_fkey" FOREIGN KEY ("social_demo_id") REFERENCES "social_demos" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "bandit_models"
+	ADD CONSTRAINT "bandit_models_slot_id_fkey" FOREIGN KEY ("slot_id") REFERENCES "slots" ("id") ON DELETE CASCADE;
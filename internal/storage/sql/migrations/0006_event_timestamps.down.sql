@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS "views_slot_id_date_idx";
+DROP INDEX IF EXISTS "clicks_slot_id_date_idx";
+
+ALTER TABLE "views" ALTER COLUMN "date" TYPE TEXT USING "date"::TEXT;
+ALTER TABLE "clicks" ALTER COLUMN "date" TYPE TEXT USING "date"::TEXT;
+
+INSERT INTO "views" SELECT * FROM "views_invalid_dates";
+INSERT INTO "clicks" SELECT * FROM "clicks_invalid_dates";
+
+DROP TABLE IF EXISTS "views_invalid_dates";
+DROP TABLE IF EXISTS "clicks_invalid_dates";
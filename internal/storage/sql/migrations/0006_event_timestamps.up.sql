@@ -0,0 +1,22 @@
+-- Dates were written by time.Time.String(), e.g. "2021-08-01 12:30:00.123456789 +0300 MSK m=+0.000000001",
+-- rows in other format are moved with their original text to clicks_invalid_dates and views_invalid_dates
+-- instead of getting invented time, so they can be checked and fixed by hand.
+CREATE FUNCTION pg_temp.is_event_time(date TEXT) RETURNS BOOLEAN AS $$
+	SELECT date ~ '^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}(\.\d+)? [+-]\d{4}'
+$$ LANGUAGE SQL;
+
+CREATE FUNCTION pg_temp.event_time(date TEXT) RETURNS TIMESTAMPTZ AS $$
+	SELECT (substring(date FROM '^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}(?:\.\d{1,6})?') || substring(date FROM ' [+-]\d{4}'))::TIMESTAMPTZ
+$$ LANGUAGE SQL;
+
+CREATE TABLE "clicks_invalid_dates" AS SELECT * FROM "clicks" WHERE NOT pg_temp.is_event_time("date");
+CREATE TABLE "views_invalid_dates" AS SELECT * FROM "views" WHERE NOT pg_temp.is_event_time("date");
+
+DELETE FROM "clicks" WHERE NOT pg_temp.is_event_time("date");
+DELETE FROM "views" WHERE NOT pg_temp.is_event_time("date");
+
+ALTER TABLE "clicks" ALTER COLUMN "date" TYPE TIMESTAMPTZ USING pg_temp.event_time("date");
+ALTER TABLE "views" ALTER COLUMN "date" TYPE TIMESTAMPTZ USING pg_temp.event_time("date");
+
+CREATE INDEX "clicks_slot_id_date_idx" ON "clicks" ("slot_id", "date");
+CREATE INDEX "views_slot_id_date_idx" ON "views" ("slot_id", "date");
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "clicks_impression_id_idx";
+
+ALTER TABLE "clicks" DROP COLUMN IF EXISTS "impression_id";
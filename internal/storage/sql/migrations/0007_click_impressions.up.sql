@@ -0,0 +1,4 @@
+-- clicks by impression token keep its id, so the same impression can't be clicked twice
+ALTER TABLE "clicks" ADD COLUMN IF NOT EXISTS "impression_id" TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS "clicks_impression_id_idx" ON "clicks" ("impression_id");
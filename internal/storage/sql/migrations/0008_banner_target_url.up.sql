@@ -0,0 +1 @@
+ALTER TABLE "banners" ADD COLUMN IF NOT EXISTS "target_url" TEXT NOT NULL DEFAULT '';
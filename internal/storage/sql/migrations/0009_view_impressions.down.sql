@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "views_impression_id_idx";
+
+ALTER TABLE "views" DROP COLUMN IF EXISTS "impression_id";
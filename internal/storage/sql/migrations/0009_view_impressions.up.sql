@@ -0,0 +1,4 @@
+-- views by impression token keep its id, so repeated hits of the same impression are counted once
+ALTER TABLE "views" ADD COLUMN IF NOT EXISTS "impression_id" TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS "views_impression_id_idx" ON "views" ("impression_id");
@@ -0,0 +1,13 @@
+-- hourly counters built by consumer of events queue, they don't reference items
+-- so analytics are kept when banners, slots or social demos are deleted
+CREATE TABLE IF NOT EXISTS "event_rollups" (
+	"slot_id" TEXT NOT NULL,
+	"banner_id" TEXT NOT NULL,
+	"social_demo_id" TEXT NOT NULL,
+	"hour" TIMESTAMPTZ NOT NULL,
+	"views" BIGINT NOT NULL DEFAULT 0,
+	"clicks" BIGINT NOT NULL DEFAULT 0,
+	PRIMARY KEY ("slot_id", "banner_id", "social_demo_id", "hour")
+);
+
+CREATE INDEX IF NOT EXISTS "event_rollups_hour_idx" ON "event_rollups" ("hour");
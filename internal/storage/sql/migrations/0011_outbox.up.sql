@@ -0,0 +1,13 @@
+-- events are written to outbox in transaction of click or view and published by relay of app,
+-- rows don't reference items so events of deleted items are published too
+CREATE TABLE IF NOT EXISTS "outbox" (
+	"id" BIGSERIAL PRIMARY KEY,
+	"type" TEXT NOT NULL,
+	"slot_id" TEXT NOT NULL,
+	"banner_id" TEXT NOT NULL,
+	"social_demo_id" TEXT NOT NULL,
+	"date" TIMESTAMPTZ NOT NULL,
+	"sent_at" TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS "outbox_pending_idx" ON "outbox" ("id") WHERE "sent_at" IS NULL;
@@ -0,0 +1,15 @@
+DELETE FROM "clicks" WHERE "slot_id" NOT IN (SELECT "id" FROM "slots")
+	OR "banner_id" NOT IN (SELECT "id" FROM "banners") OR "social_demo_id" NOT IN (SELECT "id" FROM "social_demos");
+
+DELETE FROM "views" WHERE "slot_id" NOT IN (SELECT "id" FROM "slots")
+	OR "banner_id" NOT IN (SELECT "id" FROM "banners") OR "social_demo_id" NOT IN (SELECT "id" FROM "social_demos");
+
+ALTER TABLE "clicks"
+	ADD CONSTRAINT "clicks_slot_id_fkey" FOREIGN KEY ("slot_id") REFERENCES "slots" ("id") ON DELETE CASCADE,
+	ADD CONSTRAINT "clicks_banner_id_fkey" FOREIGN KEY ("banner_id") REFERENCES "banners" ("id") ON DELETE CASCADE,
+	ADD CONSTRAINT "clicks_social_demo_id_fkey" FOREIGN KEY ("social_demo_id") REFERENCES "social_demos" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "views"
+	ADD CONSTRAINT "views_slot_id_fkey" FOREIGN KEY ("slot_id") REFERENCES "slots" ("id") ON DELETE CASCADE,
+	ADD CONSTRAINT "views_banner_id_fkey" FOREIGN KEY ("banner_id") REFERENCES "banners" ("id") ON DELETE CASCADE,
+	ADD CONSTRAINT "views_social_demo_id_fkey" FOREIGN KEY ("social_demo_id") REFERENCES "social_demos" ("id") ON DELETE CASCADE;
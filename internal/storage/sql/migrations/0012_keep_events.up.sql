@@ -0,0 +1,11 @@
+-- events are history, they are kept when banner, slot or social demo is deleted like rollups and outbox,
+-- unknown items are still rejected on insert by constraints of banner_stats written in the same transaction
+ALTER TABLE "clicks"
+	DROP CONSTRAINT IF EXISTS "clicks_slot_id_fkey",
+	DROP CONSTRAINT IF EXISTS "clicks_banner_id_fkey",
+	DROP CONSTRAINT IF EXISTS "clicks_social_demo_id_fkey";
+
+ALTER TABLE "views"
+	DROP CONSTRAINT IF EXISTS "views_slot_id_fkey",
+	DROP CONSTRAINT IF EXISTS "views_banner_id_fkey",
+	DROP CONSTRAINT IF EXISTS "views_social_demo_id_fkey";
@@ -0,0 +1,5 @@
+ALTER TABLE "outbox"
+	DROP COLUMN IF EXISTS "claimed_until",
+	ADD COLUMN IF NOT EXISTS "sent_at" TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS "outbox_pending_idx" ON "outbox" ("id") WHERE "sent_at" IS NULL;
@@ -0,0 +1,9 @@
+-- relay claims pending rows until claimed_until and commits before publishing, so broker confirms
+-- don't hold a transaction, published rows are deleted and claims of failed relay expire
+DELETE FROM "outbox" WHERE "sent_at" IS NOT NULL;
+
+DROP INDEX IF EXISTS "outbox_pending_idx";
+
+ALTER TABLE "outbox"
+	DROP COLUMN IF EXISTS "sent_at",
+	ADD COLUMN IF NOT EXISTS "claimed_until" TIMESTAMPTZ;
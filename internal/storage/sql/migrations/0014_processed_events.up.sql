@@ -0,0 +1,9 @@
+-- ids of outbox events which are added to rollups, so events published again aren't counted twice,
+-- ids are pruned by consumer after redelivery of their events isn't expected
+CREATE TABLE IF NOT EXISTS "processed_events" (
+	"id" BIGINT NOT NULL,
+	"processed_at" TIMESTAMPTZ NOT NULL,
+	PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS "processed_events_processed_at_idx" ON "processed_events" ("processed_at");
@@ -2,10 +2,16 @@ package sqlstorage
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/Fuchsoria/banners-rotation/internal/storage"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type Storage struct {
@@ -17,23 +23,41 @@ type BannerRotationItem struct {
 	BannerID string `db:"banner_id"`
 }
 
-type ClickItem struct {
+type StatsItem struct {
 	SlotID       string `db:"slot_id"`
 	BannerID     string `db:"banner_id"`
 	SocialDemoID string `db:"social_demo_id"`
-	Date         string `db:"date"`
+	Views        int    `db:"views"`
+	Clicks       int    `db:"clicks"`
 }
 
-type ViewItem struct {
-	SlotID       string `db:"slot_id"`
-	BannerID     string `db:"banner_id"`
-	SocialDemoID string `db:"social_demo_id"`
-	Date         string `db:"date"`
+type SlotStrategyItem struct {
+	SlotID   string `db:"id"`
+	Strategy string `db:"strategy"`
 }
 
-type NotViewedItem struct {
-	SlotID   string `db:"slot_id"`
-	BannerID string `db:"banner_id"`
+type BannerItem struct {
+	ID          string `db:"id"`
+	Description string `db:"description"`
+	TargetURL   string `db:"target_url"`
+}
+
+type SlotItem struct {
+	ID          string `db:"id"`
+	Description string `db:"description"`
+	Strategy    string `db:"strategy"`
+}
+
+type SocialDemoItem struct {
+	ID          string `db:"id"`
+	Description string `db:"description"`
+}
+
+type TimeStatsItem struct {
+	BannerID string    `db:"banner_id"`
+	Hour     time.Time `db:"hour"`
+	Views    int       `db:"views"`
+	Clicks   int       `db:"clicks"`
 }
 
 var ErrBannersWereRemoved = errors.New("banners were not removed from rotation")
@@ -62,7 +86,7 @@ func (s *Storage) Close() error {
 func (s *Storage) AddBannerRotation(bannerID string, slotID string) error {
 	_, err := s.db.Exec("INSERT INTO banners_rotation (slot_id,banner_id) VALUES ($1,$2)", slotID, bannerID)
 	if err != nil {
-		return fmt.Errorf("cannot insert banner to rotation, %w", err)
+		return fmt.Errorf("cannot insert banner to rotation, %w", mapError(err))
 	}
 
 	return nil
@@ -86,35 +110,118 @@ func (s *Storage) RemoveBannerRotation(bannerID string, slotID string) error {
 	return nil
 }
 
-func (s *Storage) AddClickEvent(bannerID string, slotID string, socialDemoID string, date string) error {
-	_, err := s.db.Exec("INSERT INTO clicks (slot_id,banner_id,social_demo_id,date) VALUES ($1,$2,$3,$4)", slotID, bannerID, socialDemoID, date)
+func (s *Storage) AddClickEvent(bannerID string, slotID string, socialDemoID string, date time.Time) error {
+	return s.addClickEvent(sql.NullString{}, bannerID, slotID, socialDemoID, date)
+}
+
+// AddImpressionClickEvent adds click of impression, click of already clicked impression gives ErrAlreadyExists.
+func (s *Storage) AddImpressionClickEvent(impressionID string, bannerID string, slotID string, socialDemoID string, date time.Time) error {
+	return s.addClickEvent(sql.NullString{String: impressionID, Valid: true}, bannerID, slotID, socialDemoID, date)
+}
+
+func (s *Storage) addClickEvent(impressionID sql.NullString, bannerID string, slotID string, socialDemoID string, date time.Time) error {
+	err := s.inTx(func(tx *sqlx.Tx) error {
+		_, err := tx.Exec("INSERT INTO clicks (slot_id,banner_id,social_demo_id,date,impression_id) VALUES ($1,$2,$3,$4,$5)",
+			slotID, bannerID, socialDemoID, date, impressionID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(`INSERT INTO banner_stats (slot_id,banner_id,social_demo_id,clicks) VALUES ($1,$2,$3,1)
+			ON CONFLICT (slot_id,banner_id,social_demo_id) DO UPDATE SET clicks=banner_stats.clicks+1`, slotID, bannerID, socialDemoID)
+		if err != nil {
+			return err
+		}
+
+		return addOutbox(tx, EventClick, bannerID, slotID, socialDemoID, date)
+	})
 	if err != nil {
-		return fmt.Errorf("cannot insert banner click, %w", err)
+		return fmt.Errorf("cannot insert banner click, %w", mapError(err))
 	}
 
 	return nil
 }
 
-func (s *Storage) AddViewEvent(bannerID string, slotID string, socialDemoID string, date string) error {
-	_, err := s.db.Exec("INSERT INTO views (slot_id,banner_id,social_demo_id,date) VALUES ($1,$2,$3,$4)", slotID, bannerID, socialDemoID, date)
+func (s *Storage) AddViewEvent(bannerID string, slotID string, socialDemoID string, date time.Time) error {
+	return s.addViewEvent(sql.NullString{}, bannerID, slotID, socialDemoID, date)
+}
+
+// AddImpressionViewEvent adds view of impression, view of already viewed impression gives ErrAlreadyExists.
+func (s *Storage) AddImpressionViewEvent(impressionID string, bannerID string, slotID string, socialDemoID string, date time.Time) error {
+	return s.addViewEvent(sql.NullString{String: impressionID, Valid: true}, bannerID, slotID, socialDemoID, date)
+}
+
+// HasImpressionView reports whether view of impression is added.
+func (s *Storage) HasImpressionView(impressionID string) (viewed bool, err error) {
+	err = s.db.Get(&viewed, "SELECT EXISTS (SELECT 1 FROM views WHERE impression_id=$1)", impressionID)
+	if err != nil {
+		return false, fmt.Errorf("cannot check impression view, %w", err)
+	}
+
+	return viewed, nil
+}
+
+func (s *Storage) addViewEvent(impressionID sql.NullString, bannerID string, slotID string, socialDemoID string, date time.Time) error {
+	err := s.inTx(func(tx *sqlx.Tx) error {
+		_, err := tx.Exec("INSERT INTO views (slot_id,banner_id,social_demo_id,date,impression_id) VALUES ($1,$2,$3,$4,$5)",
+			slotID, bannerID, socialDemoID, date, impressionID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(`INSERT INTO banner_stats (slot_id,banner_id,social_demo_id,views) VALUES ($1,$2,$3,1)
+			ON CONFLICT (slot_id,banner_id,social_demo_id) DO UPDATE SET views=banner_stats.views+1`, slotID, bannerID, socialDemoID)
+		if err != nil {
+			return err
+		}
+
+		return addOutbox(tx, EventView, bannerID, slotID, socialDemoID, date)
+	})
 	if err != nil {
-		return fmt.Errorf("cannot insert banner view, %w", err)
+		return fmt.Errorf("cannot insert banner view, %w", mapError(err))
 	}
 
 	return nil
 }
 
-func (s *Storage) GetNotViewedBanners(slotID string) (notViewedBanners []NotViewedItem, err error) {
-	err = s.db.Select(&notViewedBanners, "SELECT slot_id,banner_id FROM banners_rotation WHERE slot_id=$1 EXCEPT SELECT slot_id,banner_id FROM views", slotID)
+// mapError converts postgres constraint violations to storage errors.
+func mapError(err error) error {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	switch pqErr.Code.Name() {
+	case "foreign_key_violation":
+		return fmt.Errorf("%s, %w", pqErr.Message, storage.ErrNotFound)
+	case "unique_violation":
+		return fmt.Errorf("%s, %w", pqErr.Message, storage.ErrAlreadyExists)
+	}
+
+	return err
+}
+
+func (s *Storage) inTx(fn func(tx *sqlx.Tx) error) error {
+	tx, err := s.db.Beginx()
 	if err != nil {
-		return nil, fmt.Errorf("cannot get not viewed banners, %w", err)
+		return fmt.Errorf("cannot begin transaction, %w", err)
 	}
 
-	return notViewedBanners, nil
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("cannot commit transaction, %w", err)
+	}
+
+	return nil
 }
 
 func (s *Storage) GetBannersInSlot(slotID string) (bannersInSlot []BannerRotationItem, err error) {
-	err = s.db.Select(&bannersInSlot, "SELECT * FROM banners_rotation WHERE slot_id=$1", slotID)
+	err = s.db.Select(&bannersInSlot, "SELECT slot_id,banner_id FROM banners_rotation WHERE slot_id=$1", slotID)
 	if err != nil {
 		return nil, fmt.Errorf("cannot get banners from slot, %w", err)
 	}
@@ -122,47 +229,752 @@ func (s *Storage) GetBannersInSlot(slotID string) (bannersInSlot []BannerRotatio
 	return bannersInSlot, nil
 }
 
-func (s *Storage) GetBannersClicks(slotID string) (bannersClicks []ClickItem, err error) {
-	err = s.db.Select(&bannersClicks, "SELECT * FROM clicks WHERE slot_id=$1", slotID)
+func (s *Storage) GetSlotStats(slotID string) (stats []StatsItem, err error) {
+	err = s.db.Select(&stats, "SELECT slot_id,banner_id,social_demo_id,views,clicks FROM banner_stats WHERE slot_id=$1", slotID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get slot stats, %w", err)
+	}
+
+	return stats, nil
+}
+
+func (s *Storage) GetSlotsStats(slotIDs []string) (stats []StatsItem, err error) {
+	err = s.db.Select(&stats, "SELECT slot_id,banner_id,social_demo_id,views,clicks FROM banner_stats WHERE slot_id=ANY($1)", pq.Array(slotIDs))
+	if err != nil {
+		return nil, fmt.Errorf("cannot get slots stats, %w", err)
+	}
+
+	return stats, nil
+}
+
+// existingItems is a condition of clicks and views which slot, banner and social demo are not deleted,
+// events are kept after deletion of items but they are not counted.
+const existingItems = `slot_id IN (SELECT id FROM slots) AND banner_id IN (SELECT id FROM banners)
+	AND social_demo_id IN (SELECT id FROM social_demos)`
+
+// BackfillBannerStats rebuilds banner_stats from clicks and views tables of existing items,
+// new events are blocked until rebuild is finished so counters stay consistent.
+func (s *Storage) BackfillBannerStats() (rows int64, err error) {
+	err = s.inTx(func(tx *sqlx.Tx) error {
+		if _, err := tx.Exec("LOCK TABLE clicks, views IN SHARE MODE"); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec("DELETE FROM banner_stats"); err != nil {
+			return err
+		}
+
+		result, err := tx.Exec(fmt.Sprintf(`INSERT INTO banner_stats (slot_id,banner_id,social_demo_id,views,clicks)
+			SELECT slot_id, banner_id, social_demo_id, SUM(views), SUM(clicks) FROM (
+				SELECT slot_id, banner_id, social_demo_id, 1 AS views, 0 AS clicks FROM views WHERE %[1]s
+				UNION ALL
+				SELECT slot_id, banner_id, social_demo_id, 0 AS views, 1 AS clicks FROM clicks WHERE %[1]s
+			) events GROUP BY slot_id, banner_id, social_demo_id`, existingItems))
+		if err != nil {
+			return err
+		}
+
+		rows, err = result.RowsAffected()
+
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("cannot backfill banner stats, %w", err)
+	}
+
+	return rows, nil
+}
+
+const timeStatsQuery = `SELECT banner_id, hour, SUM(views) AS views, SUM(clicks) AS clicks FROM (
+		SELECT banner_id, date_trunc('hour', date) AS hour, 1 AS views, 0 AS clicks FROM views
+			WHERE %[1]s AND %[2]s AND date >= date_trunc('hour', $1::timestamptz)
+		UNION ALL
+		SELECT banner_id, date_trunc('hour', date) AS hour, 0 AS views, 1 AS clicks FROM clicks
+			WHERE %[1]s AND %[2]s AND date >= date_trunc('hour', $1::timestamptz)
+	) events GROUP BY banner_id, hour`
+
+func (s *Storage) GetBannersTimeStats(slotID string, since time.Time) (stats []TimeStatsItem, err error) {
+	err = s.db.Select(&stats, fmt.Sprintf(timeStatsQuery, "slot_id=$2", existingItems), since, slotID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get banners time stats, %w", err)
+	}
+
+	return stats, nil
+}
+
+func (s *Storage) GetBannersTimeStatsBySocialDemo(slotID string, socialDemoID string, since time.Time) (stats []TimeStatsItem, err error) {
+	err = s.db.Select(&stats, fmt.Sprintf(timeStatsQuery, "slot_id=$2 AND social_demo_id=$3", existingItems), since, slotID, socialDemoID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get banners time stats by social demo, %w", err)
+	}
+
+	return stats, nil
+}
+
+func (s *Storage) GetSlotsStrategies(slotIDs []string) (strategies []SlotStrategyItem, err error) {
+	err = s.db.Select(&strategies, "SELECT id,COALESCE(strategy,'') AS strategy FROM slots WHERE id=ANY($1)", pq.Array(slotIDs))
+	if err != nil {
+		return nil, fmt.Errorf("cannot get slots strategies, %w", err)
+	}
+
+	return strategies, nil
+}
+
+func (s *Storage) GetBannersInSlots(slotIDs []string) (bannersInSlots []BannerRotationItem, err error) {
+	err = s.db.Select(&bannersInSlots, "SELECT slot_id,banner_id FROM banners_rotation WHERE slot_id=ANY($1)", pq.Array(slotIDs))
 	if err != nil {
-		return nil, fmt.Errorf("cannot get clicked banners, %w", err)
+		return nil, fmt.Errorf("cannot get banners from slots, %w", err)
 	}
 
-	return bannersClicks, nil
+	return bannersInSlots, nil
 }
 
-func (s *Storage) GetBannersViews(slotID string) (bannersViews []ViewItem, err error) {
-	err = s.db.Select(&bannersViews, "SELECT * FROM views WHERE slot_id=$1", slotID)
+func (s *Storage) GetBanditModel(slotID string, strategy string) (model []byte, err error) {
+	err = s.db.Get(&model, "SELECT model FROM bandit_models WHERE slot_id=$1 AND strategy=$2", slotID, strategy)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
 	if err != nil {
-		return nil, fmt.Errorf("cannot get viewed banners, %w", err)
+		return nil, fmt.Errorf("cannot get bandit model, %w", err)
 	}
 
-	return bannersViews, nil
+	return model, nil
 }
 
-func (s *Storage) CreateBanner(id string, description string) (string, error) {
-	_, err := s.db.Exec("INSERT INTO banners (id,description) VALUES ($1,$2)", id, description)
+// UpdateBanditModel replaces model of slot strategy with result of update, row is locked in transaction,
+// so concurrent updates of other instances are applied to the latest model one after another.
+// Model is nil for update when it is not saved yet.
+func (s *Storage) UpdateBanditModel(slotID string, strategy string, update func(model []byte) ([]byte, error)) error {
+	err := s.inTx(func(tx *sqlx.Tx) error {
+		_, err := tx.Exec(`INSERT INTO bandit_models (slot_id,strategy,model) VALUES ($1,$2,'null')
+			ON CONFLICT (slot_id,strategy) DO NOTHING`, slotID, strategy)
+		if err != nil {
+			return err
+		}
+
+		var model []byte
+
+		err = tx.Get(&model, "SELECT model FROM bandit_models WHERE slot_id=$1 AND strategy=$2 FOR UPDATE", slotID, strategy)
+		if err != nil {
+			return err
+		}
+
+		if string(model) == "null" {
+			model = nil
+		}
+
+		if model, err = update(model); err != nil {
+			return err
+		}
+
+		_, err = tx.Exec("UPDATE bandit_models SET model=$3 WHERE slot_id=$1 AND strategy=$2", slotID, strategy, model)
+
+		return err
+	})
 	if err != nil {
-		return "", fmt.Errorf("cannot insert banner, %w", err)
+		return fmt.Errorf("cannot update bandit model, %w", mapError(err))
+	}
+
+	return nil
+}
+
+func (s *Storage) CreateBanner(id string, description string, targetURL string) (string, error) {
+	_, err := s.db.Exec("INSERT INTO banners (id,description,target_url) VALUES ($1,$2,$3)", id, description, targetURL)
+	if err != nil {
+		return "", fmt.Errorf("cannot insert banner, %w", mapError(err))
 	}
 
 	return id, nil
 }
 
-func (s *Storage) CreateSlot(id string, description string) (string, error) {
-	_, err := s.db.Exec("INSERT INTO slots (id,description) VALUES ($1,$2)", id, description)
+func (s *Storage) CreateSlot(id string, description string, strategy string) (string, error) {
+	_, err := s.db.Exec("INSERT INTO slots (id,description,strategy) VALUES ($1,$2,$3)", id, description, strategy)
 	if err != nil {
-		return "", fmt.Errorf("cannot insert slot, %w", err)
+		return "", fmt.Errorf("cannot insert slot, %w", mapError(err))
 	}
 
 	return id, nil
 }
 
+func (s *Storage) GetSlotStrategy(slotID string) (strategy string, err error) {
+	err = s.db.Get(&strategy, "SELECT COALESCE(strategy,'') FROM slots WHERE id=$1", slotID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("cannot get slot strategy, %w", err)
+	}
+
+	return strategy, nil
+}
+
 func (s *Storage) CreateSocialDemo(id string, description string) (string, error) {
 	_, err := s.db.Exec("INSERT INTO social_demos (id,description) VALUES ($1,$2)", id, description)
 	if err != nil {
-		return "", fmt.Errorf("cannot insert social demo, %w", err)
+		return "", fmt.Errorf("cannot insert social demo, %w", mapError(err))
 	}
 
 	return id, nil
 }
+
+func (s *Storage) GetBannerItem(id string) (banner BannerItem, err error) {
+	err = s.db.Get(&banner, "SELECT id,COALESCE(description,'') AS description,target_url FROM banners WHERE id=$1", id)
+	if err != nil {
+		return BannerItem{}, fmt.Errorf("cannot get banner, %w", notFound(err))
+	}
+
+	return banner, nil
+}
+
+func (s *Storage) ListBanners(afterID string, limit int) (banners []BannerItem, err error) {
+	err = s.db.Select(&banners, `SELECT id,COALESCE(description,'') AS description,target_url FROM banners
+		WHERE id>$1 ORDER BY id LIMIT $2`, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list banners, %w", err)
+	}
+
+	return banners, nil
+}
+
+// UpdateBanner changes fields which are not nil, other fields are kept.
+func (s *Storage) UpdateBanner(id string, description *string, targetURL *string) error {
+	result, err := s.db.Exec("UPDATE banners SET description=COALESCE($2,description),target_url=COALESCE($3,target_url) WHERE id=$1",
+		id, description, targetURL)
+	if err != nil {
+		return fmt.Errorf("cannot update banner, %w", err)
+	}
+
+	return checkAffected(result, "cannot update banner")
+}
+
+// DeleteBanner removes banner with its rotations and statistics, events are kept.
+func (s *Storage) DeleteBanner(id string) error {
+	result, err := s.db.Exec("DELETE FROM banners WHERE id=$1", id)
+	if err != nil {
+		return fmt.Errorf("cannot delete banner, %w", err)
+	}
+
+	return checkAffected(result, "cannot delete banner")
+}
+
+func (s *Storage) GetSlotItem(id string) (slot SlotItem, err error) {
+	err = s.db.Get(&slot, "SELECT id,COALESCE(description,'') AS description,COALESCE(strategy,'') AS strategy FROM slots WHERE id=$1", id)
+	if err != nil {
+		return SlotItem{}, fmt.Errorf("cannot get slot, %w", notFound(err))
+	}
+
+	return slot, nil
+}
+
+func (s *Storage) ListSlots(afterID string, limit int) (slots []SlotItem, err error) {
+	err = s.db.Select(&slots, `SELECT id,COALESCE(description,'') AS description,COALESCE(strategy,'') AS strategy FROM slots
+		WHERE id>$1 ORDER BY id LIMIT $2`, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list slots, %w", err)
+	}
+
+	return slots, nil
+}
+
+// UpdateSlot changes fields which are not nil, other fields are kept.
+func (s *Storage) UpdateSlot(id string, description *string, strategy *string) error {
+	result, err := s.db.Exec("UPDATE slots SET description=COALESCE($2,description),strategy=COALESCE($3,strategy) WHERE id=$1",
+		id, description, strategy)
+	if err != nil {
+		return fmt.Errorf("cannot update slot, %w", err)
+	}
+
+	return checkAffected(result, "cannot update slot")
+}
+
+// DeleteSlot removes slot with its rotations, statistics and bandit models, events are kept.
+func (s *Storage) DeleteSlot(id string) error {
+	result, err := s.db.Exec("DELETE FROM slots WHERE id=$1", id)
+	if err != nil {
+		return fmt.Errorf("cannot delete slot, %w", err)
+	}
+
+	return checkAffected(result, "cannot delete slot")
+}
+
+func (s *Storage) GetSocialDemoItem(id string) (socialDemo SocialDemoItem, err error) {
+	err = s.db.Get(&socialDemo, "SELECT id,COALESCE(description,'') AS description FROM social_demos WHERE id=$1", id)
+	if err != nil {
+		return SocialDemoItem{}, fmt.Errorf("cannot get social demo, %w", notFound(err))
+	}
+
+	return socialDemo, nil
+}
+
+func (s *Storage) ListSocialDemos(afterID string, limit int) (socialDemos []SocialDemoItem, err error) {
+	err = s.db.Select(&socialDemos, "SELECT id,COALESCE(description,'') AS description FROM social_demos WHERE id>$1 ORDER BY id LIMIT $2", afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list social demos, %w", err)
+	}
+
+	return socialDemos, nil
+}
+
+func (s *Storage) UpdateSocialDemo(id string, description string) error {
+	result, err := s.db.Exec("UPDATE social_demos SET description=$2 WHERE id=$1", id, description)
+	if err != nil {
+		return fmt.Errorf("cannot update social demo, %w", err)
+	}
+
+	return checkAffected(result, "cannot update social demo")
+}
+
+// DeleteSocialDemo removes social demo with its statistics, events are kept.
+func (s *Storage) DeleteSocialDemo(id string) error {
+	result, err := s.db.Exec("DELETE FROM social_demos WHERE id=$1", id)
+	if err != nil {
+		return fmt.Errorf("cannot delete social demo, %w", err)
+	}
+
+	return checkAffected(result, "cannot delete social demo")
+}
+
+func notFound(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return storage.ErrNotFound
+	}
+
+	return err
+}
+
+func checkAffected(result sql.Result, message string) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("cannot get affected rows count, %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s, %w", message, storage.ErrNotFound)
+	}
+
+	return nil
+}
+
+// GetSlotRotation returns banners in rotation of slot ordered by id.
+func (s *Storage) GetSlotRotation(slotID string) (banners []BannerItem, err error) {
+	err = s.db.Select(&banners, `SELECT b.id,COALESCE(b.description,'') AS description,b.target_url FROM banners_rotation r
+		JOIN banners b ON b.id=r.banner_id WHERE r.slot_id=$1 ORDER BY b.id`, slotID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get slot rotation, %w", err)
+	}
+
+	return banners, nil
+}
+
+// GetBannerSlots returns slots which rotate banner ordered by id.
+func (s *Storage) GetBannerSlots(bannerID string) (slots []SlotItem, err error) {
+	err = s.db.Select(&slots, `SELECT s.id,COALESCE(s.description,'') AS description,COALESCE(s.strategy,'') AS strategy FROM banners_rotation r
+		JOIN slots s ON s.id=r.slot_id WHERE r.banner_id=$1 ORDER BY s.id`, bannerID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get banner slots, %w", err)
+	}
+
+	return slots, nil
+}
+
+const (
+	StatsBySlot       = "slot"
+	StatsByBanner     = "banner"
+	StatsBySocialDemo = "social_demo"
+
+	BucketHour = "hour"
+	BucketDay  = "day"
+	BucketWeek = "week"
+)
+
+var (
+	statsColumns = map[string]string{StatsBySlot: "slot_id", StatsByBanner: "banner_id", StatsBySocialDemo: "social_demo_id"}
+	statsBuckets = map[string]bool{BucketHour: true, BucketDay: true, BucketWeek: true}
+
+	ErrInvalidStatsQuery = errors.New("invalid stats query")
+)
+
+// StatsQuery selects events in [From, To) range, zero times are not limited,
+// empty ids are not filtered and empty Bucket means the whole range.
+type StatsQuery struct {
+	GroupBy      []string
+	Bucket       string
+	From         time.Time
+	To           time.Time
+	SlotID       string
+	BannerID     string
+	SocialDemoID string
+}
+
+// StatsRow has empty ids for fields which are not grouped by and nil Time without bucket.
+type StatsRow struct {
+	SlotID       string     `db:"slot_id"`
+	BannerID     string     `db:"banner_id"`
+	SocialDemoID string     `db:"social_demo_id"`
+	Time         *time.Time `db:"time"`
+	Views        int        `db:"views"`
+	Clicks       int        `db:"clicks"`
+}
+
+// Validate checks group by fields and bucket.
+func (q StatsQuery) Validate() error {
+	for _, field := range q.GroupBy {
+		if _, ok := statsColumns[field]; !ok {
+			return fmt.Errorf("unknown group by field %q, %w", field, ErrInvalidStatsQuery)
+		}
+	}
+
+	if q.Bucket != "" && !statsBuckets[q.Bucket] {
+		return fmt.Errorf("unknown time bucket %q, %w", q.Bucket, ErrInvalidStatsQuery)
+	}
+
+	if !q.From.IsZero() && !q.To.IsZero() && !q.From.Before(q.To) {
+		return fmt.Errorf("from should be before to, %w", ErrInvalidStatsQuery)
+	}
+
+	return nil
+}
+
+// EventRow is a raw click or view event of export.
+type EventRow struct {
+	Type         string    `db:"type"`
+	SlotID       string    `db:"slot_id"`
+	BannerID     string    `db:"banner_id"`
+	SocialDemoID string    `db:"social_demo_id"`
+	Date         time.Time `db:"date"`
+}
+
+const (
+	EventView  = "view"
+	EventClick = "click"
+)
+
+// GetStats aggregates clicks and views, time buckets are aligned in UTC and weeks start on monday.
+func (s *Storage) GetStats(query StatsQuery) (rows []StatsRow, err error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	statement, args := statsStatement(query)
+
+	if err := s.db.Select(&rows, statement, args...); err != nil {
+		return nil, fmt.Errorf("cannot get stats, %w", err)
+	}
+
+	return rows, nil
+}
+
+// StreamStats passes GetStats rows to fn one by one while they are read from the database cursor.
+func (s *Storage) StreamStats(query StatsQuery, fn func(row StatsRow) error) error {
+	if err := query.Validate(); err != nil {
+		return err
+	}
+
+	statement, args := statsStatement(query)
+
+	err := s.stream(statement, args, func(rows *sqlx.Rows) error {
+		var row StatsRow
+
+		if err := rows.StructScan(&row); err != nil {
+			return err
+		}
+
+		return fn(row)
+	})
+	if err != nil {
+		return fmt.Errorf("cannot stream stats, %w", err)
+	}
+
+	return nil
+}
+
+// StreamEvents passes raw events of query range and filters ordered by date to fn,
+// group by fields and bucket of query are ignored.
+func (s *Storage) StreamEvents(query StatsQuery, fn func(row EventRow) error) error {
+	if err := query.Validate(); err != nil {
+		return err
+	}
+
+	where, args := statsConditions(query)
+
+	statement := fmt.Sprintf(`SELECT 'view' AS type, slot_id, banner_id, social_demo_id, date FROM views WHERE %[1]s
+		UNION ALL
+		SELECT 'click' AS type, slot_id, banner_id, social_demo_id, date FROM clicks WHERE %[1]s
+		ORDER BY date`, where)
+
+	err := s.stream(statement, args, func(rows *sqlx.Rows) error {
+		var row EventRow
+
+		if err := rows.StructScan(&row); err != nil {
+			return err
+		}
+
+		return fn(row)
+	})
+	if err != nil {
+		return fmt.Errorf("cannot stream events, %w", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) stream(statement string, args []interface{}, fn func(rows *sqlx.Rows) error) error {
+	rows, err := s.db.Queryx(statement, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := fn(rows); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// statsConditions returns where clause of query range and filters for clicks and views of existing items.
+func statsConditions(query StatsQuery) (string, []interface{}) {
+	conditions := []string{existingItems}
+	args := []interface{}{}
+
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if !query.From.IsZero() {
+		addCondition("date>=$%d", query.From)
+	}
+
+	if !query.To.IsZero() {
+		addCondition("date<$%d", query.To)
+	}
+
+	if query.SlotID != "" {
+		addCondition("slot_id=$%d", query.SlotID)
+	}
+
+	if query.BannerID != "" {
+		addCondition("banner_id=$%d", query.BannerID)
+	}
+
+	if query.SocialDemoID != "" {
+		addCondition("social_demo_id=$%d", query.SocialDemoID)
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// statsStatement builds aggregation of validated query, columns and bucket are taken from whitelists.
+func statsStatement(query StatsQuery) (string, []interface{}) {
+	selected := map[string]bool{}
+
+	for _, field := range query.GroupBy {
+		selected[statsColumns[field]] = true
+	}
+
+	columns := []string{}
+	groups := []string{}
+
+	for _, column := range []string{"slot_id", "banner_id", "social_demo_id"} {
+		if selected[column] {
+			columns = append(columns, column)
+			groups = append(groups, column)
+		} else {
+			columns = append(columns, "'' AS "+column)
+		}
+	}
+
+	if query.Bucket != "" {
+		columns = append(columns, fmt.Sprintf("date_trunc('%s', date AT TIME ZONE 'UTC') AT TIME ZONE 'UTC' AS time", query.Bucket))
+		groups = append(groups, "time")
+	} else {
+		columns = append(columns, "NULL::timestamptz AS time")
+	}
+
+	groupBy := ""
+	if len(groups) > 0 {
+		groupBy = "GROUP BY " + strings.Join(groups, ",") + " ORDER BY " + strings.Join(groups, ",")
+	}
+
+	where, args := statsConditions(query)
+
+	return fmt.Sprintf(`SELECT %s, COALESCE(SUM(views), 0) AS views, COALESCE(SUM(clicks), 0) AS clicks FROM (
+			SELECT slot_id, banner_id, social_demo_id, date, 1 AS views, 0 AS clicks FROM views WHERE %[2]s
+			UNION ALL
+			SELECT slot_id, banner_id, social_demo_id, date, 0 AS views, 1 AS clicks FROM clicks WHERE %[2]s
+		) events %[3]s`, strings.Join(columns, ","), where, groupBy), args
+}
+
+// BucketStart returns UTC start of time bucket which contains date, it matches date_trunc of GetStats.
+func BucketStart(date time.Time, bucket string) time.Time {
+	date = date.UTC()
+
+	switch bucket {
+	case BucketHour:
+		return date.Truncate(time.Hour)
+	case BucketDay:
+		return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	case BucketWeek:
+		day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	default:
+		return date
+	}
+}
+
+var (
+	rollupColumns = map[string]string{EventView: "views", EventClick: "clicks"}
+
+	ErrUnknownEventType = errors.New("unknown event type")
+)
+
+// RollupRow has views and clicks of slot, banner and social demo during hour.
+type RollupRow struct {
+	SlotID       string    `db:"slot_id"`
+	BannerID     string    `db:"banner_id"`
+	SocialDemoID string    `db:"social_demo_id"`
+	Hour         time.Time `db:"hour"`
+	Views        int       `db:"views"`
+	Clicks       int       `db:"clicks"`
+}
+
+// AddEventRollup counts view or click event in UTC hour of its date, event with positive id
+// is counted once and its repeated delivery is skipped.
+func (s *Storage) AddEventRollup(
+	eventID int64,
+	eventType string,
+	bannerID string,
+	slotID string,
+	socialDemoID string,
+	date time.Time,
+) error {
+	column, ok := rollupColumns[eventType]
+	if !ok {
+		return fmt.Errorf("cannot add event rollup, %q, %w", eventType, ErrUnknownEventType)
+	}
+
+	err := s.inTx(func(tx *sqlx.Tx) error {
+		if eventID > 0 {
+			result, err := tx.Exec("INSERT INTO processed_events (id,processed_at) VALUES ($1,now()) ON CONFLICT DO NOTHING", eventID)
+			if err != nil {
+				return err
+			}
+
+			if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+				return err
+			}
+		}
+
+		_, err := tx.Exec(fmt.Sprintf(`INSERT INTO event_rollups (slot_id,banner_id,social_demo_id,hour,%[1]s) VALUES ($1,$2,$3,$4,1)
+			ON CONFLICT (slot_id,banner_id,social_demo_id,hour) DO UPDATE SET %[1]s=event_rollups.%[1]s+1`, column),
+			slotID, bannerID, socialDemoID, BucketStart(date, BucketHour))
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("cannot add event rollup, %w", err)
+	}
+
+	return nil
+}
+
+// PruneProcessedEvents removes ids of events processed before date.
+func (s *Storage) PruneProcessedEvents(before time.Time) (int64, error) {
+	result, err := s.db.Exec("DELETE FROM processed_events WHERE processed_at<$1", before)
+	if err != nil {
+		return 0, fmt.Errorf("cannot prune processed events, %w", err)
+	}
+
+	pruned, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("cannot prune processed events, %w", err)
+	}
+
+	return pruned, nil
+}
+
+// GetEventRollups returns rollups of hours in [from, to) range ordered by hour.
+func (s *Storage) GetEventRollups(from time.Time, to time.Time) (rows []RollupRow, err error) {
+	err = s.db.Select(&rows, `SELECT slot_id,banner_id,social_demo_id,hour,views,clicks FROM event_rollups
+		WHERE hour >= $1 AND hour < $2 ORDER BY hour,slot_id,banner_id,social_demo_id`, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get event rollups, %w", err)
+	}
+
+	return rows, nil
+}
+
+// OutboxRow is event waiting for publishing.
+type OutboxRow struct {
+	ID           int64     `db:"id"`
+	Type         string    `db:"type"`
+	SlotID       string    `db:"slot_id"`
+	BannerID     string    `db:"banner_id"`
+	SocialDemoID string    `db:"social_demo_id"`
+	Date         time.Time `db:"date"`
+}
+
+func addOutbox(tx *sqlx.Tx, eventType string, bannerID string, slotID string, socialDemoID string, date time.Time) error {
+	_, err := tx.Exec("INSERT INTO outbox (type,slot_id,banner_id,social_demo_id,date) VALUES ($1,$2,$3,$4,$5)",
+		eventType, slotID, bannerID, socialDemoID, date)
+
+	return err
+}
+
+// PublishOutbox claims up to limit pending events until date+claim and commits, then passes them to publish
+// in order until it fails. Published events are deleted and the rest are released for the next run.
+// Claims keep relays of several instances from publishing the same events and expire when relay is stopped.
+func (s *Storage) PublishOutbox(limit int, date time.Time, claim time.Duration, publish func(row OutboxRow) error) (int, error) {
+	var rows []OutboxRow
+
+	err := s.db.Select(&rows, `UPDATE outbox SET claimed_until=$2 WHERE id IN (
+			SELECT id FROM outbox WHERE claimed_until IS NULL OR claimed_until<$3 ORDER BY id LIMIT $1 FOR UPDATE SKIP LOCKED
+		) RETURNING id,type,slot_id,banner_id,social_demo_id,date`, limit, date.Add(claim), date)
+	if err != nil {
+		return 0, fmt.Errorf("cannot claim outbox, %w", err)
+	}
+
+	sort.Slice(rows, func(i, j int) bool { return rows[i].ID < rows[j].ID })
+
+	var (
+		sent       int
+		publishErr error
+	)
+
+	for ; sent < len(rows); sent++ {
+		if publishErr = publish(rows[sent]); publishErr != nil {
+			break
+		}
+	}
+
+	if sent > 0 {
+		if _, err := s.db.Exec("DELETE FROM outbox WHERE id=ANY($1)", pq.Array(outboxIDs(rows[:sent]))); err != nil {
+			return 0, fmt.Errorf("cannot delete sent outbox, %w", err)
+		}
+	}
+
+	if sent < len(rows) {
+		// released rows are published by the next run in order, otherwise they wait for expiration of claim
+		if _, err := s.db.Exec("UPDATE outbox SET claimed_until=NULL WHERE id=ANY($1)", pq.Array(outboxIDs(rows[sent:]))); err != nil {
+			return sent, fmt.Errorf("cannot release outbox, %w", err)
+		}
+	}
+
+	return sent, publishErr
+}
+
+func outboxIDs(rows []OutboxRow) []int64 {
+	ids := make([]int64, 0, len(rows))
+
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+
+	return ids
+}
@@ -3,10 +3,12 @@ module github.com/Fuchsoria/banners-rotation/tests
 go 1.16
 
 require (
-	github.com/Fuchsoria/banners-rotation v0.0.0-20210814120559-54d8d42c7b74
+	github.com/Fuchsoria/banners-rotation v0.0.0-00010101000000-000000000000
 	github.com/google/uuid v1.3.0
 	github.com/jmoiron/sqlx v1.3.4
 	github.com/lib/pq v1.10.2
 	github.com/streadway/amqp v1.0.0
 	github.com/stretchr/testify v1.7.0
 )
+
+replace github.com/Fuchsoria/banners-rotation => ../
@@ -3,9 +3,11 @@ package scripts
 import (
 	"bytes"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"net/http"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -23,6 +25,17 @@ type CreateBody struct {
 	Description string `json:"description"`
 }
 
+type BannerBody struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	TargetURL   string `json:"target_url"`
+}
+
+type ServedBannerResponse struct {
+	ID              string `json:"id"`
+	ImpressionToken string `json:"impressionToken"`
+}
+
 type AddBannerBody struct {
 	BannerID string `json:"banner_id"`
 	SlotID   string `json:"slot_id"`
@@ -48,6 +61,11 @@ type IDResponse struct {
 	ID string `json:"id"`
 }
 
+type ListSlotsResponse struct {
+	Slots         []CreateBody `json:"slots"`
+	NextPageToken string       `json:"nextPageToken"`
+}
+
 type MessageResponse struct {
 	Message string `json:"message"`
 }
@@ -63,23 +81,24 @@ type RotationDB struct {
 }
 
 type ClickDB struct {
-	BannerID     string `db:"banner_id"`
-	SlotID       string `db:"slot_id"`
-	SocialDemoID string `db:"social_demo_id"`
-	Date         string `db:"date"`
+	BannerID     string    `db:"banner_id"`
+	SlotID       string    `db:"slot_id"`
+	SocialDemoID string    `db:"social_demo_id"`
+	Date         time.Time `db:"date"`
 }
 
 type ViewDB struct {
-	BannerID     string `db:"banner_id"`
-	SlotID       string `db:"slot_id"`
-	SocialDemoID string `db:"social_demo_id"`
-	Date         string `db:"date"`
+	BannerID     string    `db:"banner_id"`
+	SlotID       string    `db:"slot_id"`
+	SocialDemoID string    `db:"social_demo_id"`
+	Date         time.Time `db:"date"`
 }
 
 var (
 	HTTPHost    = os.Getenv("TESTS_HTTP_HOST")
 	PostgresDSN = os.Getenv("TESTS_POSTGRES_DSN")
 	AmpqDSN     = os.Getenv("TESTS_AMQP_DSN")
+	StorageType = os.Getenv("TESTS_STORAGE_TYPE")
 )
 
 func init() {
@@ -97,28 +116,25 @@ func init() {
 }
 
 func TestStorage(t *testing.T) {
-	conn, err := amqp.Dial(AmpqDSN)
-	require.NoError(t, err)
+	if StorageType == "memory" {
+		t.Skip("service is running with in-memory storage")
+	}
 
 	db, err := sqlx.ConnectContext(context.Background(), "postgres", PostgresDSN)
 	require.NoError(t, err)
 
-	producer := simpleproducer.New("banners-rotation", conn)
-	err = producer.Connect()
-	require.NoError(t, err, "should be without errors")
-
 	storage, err := sqlstorage.New(context.Background(), PostgresDSN)
 	require.NoError(t, err, "should be without errors")
 
 	t.Run("test banner create", func(t *testing.T) {
 		id := uuid.NewString()
 
-		_, err := storage.CreateBanner(id, "")
+		_, err := storage.CreateBanner(id, "", "")
 		require.NoError(t, err, "should be without errors")
 
 		var banner ItemDB
 
-		err = db.Get(&banner, "SELECT * FROM banners WHERE id=$1", id)
+		err = db.Get(&banner, "SELECT id,description FROM banners WHERE id=$1", id)
 		require.NoError(t, err, "should be without errors")
 		require.Equal(t, id, banner.ID, "item should be created in db")
 	})
@@ -126,12 +142,12 @@ func TestStorage(t *testing.T) {
 	t.Run("test slot create", func(t *testing.T) {
 		id := uuid.NewString()
 
-		_, err := storage.CreateSlot(id, "")
+		_, err := storage.CreateSlot(id, "", "")
 		require.NoError(t, err, "should be without errors")
 
 		var slot ItemDB
 
-		err = db.Get(&slot, "SELECT * FROM slots WHERE id=$1", id)
+		err = db.Get(&slot, "SELECT id,description FROM slots WHERE id=$1", id)
 		require.NoError(t, err, "should be without errors")
 		require.Equal(t, id, slot.ID, "item should be created in db")
 	})
@@ -153,6 +169,8 @@ func TestStorage(t *testing.T) {
 		bannerID := uuid.NewString()
 		slotID := uuid.NewString()
 
+		createItems(t, db, slotID, bannerID, "")
+
 		err := storage.AddBannerRotation(bannerID, slotID)
 		require.NoError(t, err, "should be without errors")
 
@@ -168,7 +186,9 @@ func TestStorage(t *testing.T) {
 		bannerID := uuid.NewString()
 		slotID := uuid.NewString()
 
-		_, err := db.Query("INSERT INTO banners_rotation (slot_id, banner_id) VALUES ($1, $2)", slotID, bannerID)
+		createItems(t, db, slotID, bannerID, "")
+
+		_, err := db.Exec("INSERT INTO banners_rotation (slot_id, banner_id) VALUES ($1, $2)", slotID, bannerID)
 		require.NoError(t, err, "should be without errors")
 
 		err = storage.RemoveBannerRotation(bannerID, slotID)
@@ -186,12 +206,14 @@ func TestStorage(t *testing.T) {
 		slotID := uuid.NewString()
 		socialDemoID := uuid.NewString()
 
-		err := storage.AddClickEvent(bannerID, slotID, socialDemoID, time.Now().String())
+		createItems(t, db, slotID, bannerID, socialDemoID)
+
+		err := storage.AddClickEvent(bannerID, slotID, socialDemoID, time.Now())
 		require.NoError(t, err, "should be without errors")
 
 		var click ClickDB
 
-		err = db.Get(&click, "SELECT * FROM clicks WHERE slot_id=$1 AND banner_id=$2 AND social_demo_id=$3", slotID, bannerID, socialDemoID)
+		err = db.Get(&click, "SELECT slot_id,banner_id,social_demo_id,date FROM clicks WHERE slot_id=$1 AND banner_id=$2 AND social_demo_id=$3", slotID, bannerID, socialDemoID)
 		require.NoError(t, err, "should be without errors")
 		require.Equal(t, bannerID, click.BannerID, "item should be created in db")
 		require.Equal(t, slotID, click.SlotID, "item should be created in db")
@@ -204,12 +226,14 @@ func TestStorage(t *testing.T) {
 		slotID := uuid.NewString()
 		socialDemoID := uuid.NewString()
 
-		err := storage.AddViewEvent(bannerID, slotID, socialDemoID, time.Now().String())
+		createItems(t, db, slotID, bannerID, socialDemoID)
+
+		err := storage.AddViewEvent(bannerID, slotID, socialDemoID, time.Now())
 		require.NoError(t, err, "should be without errors")
 
 		var view ViewDB
 
-		err = db.Get(&view, "SELECT * FROM views WHERE slot_id=$1 AND banner_id=$2 AND social_demo_id=$3", slotID, bannerID, socialDemoID)
+		err = db.Get(&view, "SELECT slot_id,banner_id,social_demo_id,date FROM views WHERE slot_id=$1 AND banner_id=$2 AND social_demo_id=$3", slotID, bannerID, socialDemoID)
 		require.NoError(t, err, "should be without errors")
 		require.Equal(t, bannerID, view.BannerID, "item should be created in db")
 		require.Equal(t, slotID, view.SlotID, "item should be created in db")
@@ -217,91 +241,89 @@ func TestStorage(t *testing.T) {
 		require.NotEmpty(t, view.Date, "date should exist")
 	})
 
-	t.Run("test get banners clicks", func(t *testing.T) {
-		slotID := uuid.NewString()
+	t.Run("test get banners in slot", func(t *testing.T) {
 		bannerID := uuid.NewString()
-		socialDemoID := uuid.NewString()
-		date := time.Now().String()
+		slotID := uuid.NewString()
 
-		_, err := db.Exec("INSERT INTO clicks (slot_id,banner_id,social_demo_id,date) VALUES ($1,$2,$3,$4)", slotID, bannerID, socialDemoID, date)
+		createItems(t, db, slotID, bannerID, "")
+
+		_, err := db.Exec("INSERT INTO banners_rotation (slot_id, banner_id) VALUES ($1, $2)", slotID, bannerID)
 		require.NoError(t, err, "should be without errors")
 
-		clicks, err := storage.GetBannersClicks(slotID)
+		bannersInSlot, err := storage.GetBannersInSlot(slotID)
 		require.NoError(t, err, "should be without errors")
 
-		require.Len(t, clicks, 1, "slice should have 1 item")
-		require.NotEmpty(t, clicks[0], "first item should exist")
-		require.Equal(t, bannerID, clicks[0].BannerID, "bannerID should be same")
-		require.Equal(t, slotID, clicks[0].SlotID, "slotID should be same")
-		require.Equal(t, socialDemoID, clicks[0].SocialDemoID, "socialDemoID should be same")
-		require.Equal(t, date, clicks[0].Date, "date should be same")
+		require.Len(t, bannersInSlot, 1)
+		require.Equal(t, bannerID, bannersInSlot[0].BannerID, "bannerID should be same")
+		require.Equal(t, slotID, bannersInSlot[0].SlotID, "slotID should be same")
 	})
+}
 
-	t.Run("test get banners views", func(t *testing.T) {
-		slotID := uuid.NewString()
-		bannerID := uuid.NewString()
-		socialDemoID := uuid.NewString()
-		date := time.Now().String()
+type testLogger struct{}
 
-		_, err := db.Exec("INSERT INTO views (slot_id,banner_id,social_demo_id,date) VALUES ($1,$2,$3,$4)", slotID, bannerID, socialDemoID, date)
-		require.NoError(t, err, "should be without errors")
+func (l testLogger) Info(msg string, keysAndValues ...interface{}) {}
+func (l testLogger) Warn(msg string, keysAndValues ...interface{}) {}
 
-		views, err := storage.GetBannersViews(slotID)
-		require.NoError(t, err, "should be without errors")
+// testDialer dials amqp and keeps opened connections, so test can close them.
+type testDialer struct {
+	mu    sync.Mutex
+	conns []*amqp.Connection
+}
 
-		require.Len(t, views, 1, "slice should have 1 item")
-		require.NotEmpty(t, views[0], "first item should exist")
-		require.Equal(t, bannerID, views[0].BannerID, "bannerID should be same")
-		require.Equal(t, slotID, views[0].SlotID, "slotID should be same")
-		require.Equal(t, socialDemoID, views[0].SocialDemoID, "socialDemoID should be same")
-		require.Equal(t, date, views[0].Date, "date should be same")
-	})
+func (d *testDialer) dial() (simpleproducer.RMQConnection, error) {
+	conn, err := amqp.Dial(AmpqDSN)
+	if err != nil {
+		return nil, err
+	}
 
-	t.Run("test get not viewed banners", func(t *testing.T) {
-		bannerID := uuid.NewString()
-		slotID := uuid.NewString()
+	d.mu.Lock()
+	d.conns = append(d.conns, conn)
+	d.mu.Unlock()
 
-		_, err := db.Query("INSERT INTO banners_rotation (slot_id, banner_id) VALUES ($1, $2)", slotID, bannerID)
-		require.NoError(t, err, "should be without errors")
+	return conn, nil
+}
 
-		notViewedBanners, err := storage.GetNotViewedBanners(slotID)
-		require.NoError(t, err, "should be without errors")
+func (d *testDialer) last() (*amqp.Connection, int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
 
-		require.Len(t, notViewedBanners, 1)
-		require.NotEmpty(t, notViewedBanners[0])
-		require.Equal(t, bannerID, notViewedBanners[0].BannerID, "bannerID should be same")
-		require.Equal(t, slotID, notViewedBanners[0].SlotID, "slotID should be same")
-	})
+	return d.conns[len(d.conns)-1], len(d.conns)
+}
 
-	t.Run("test get not viewed banners with 0 unviewed items", func(t *testing.T) {
-		bannerID := uuid.NewString()
-		slotID := uuid.NewString()
+func TestProducer(t *testing.T) {
+	backoff := simpleproducer.Backoff{Min: 50 * time.Millisecond, Max: time.Second}
+	message := simpleproducer.AMQPMessage{Type: "view", SlotID: "slot", BannerID: "banner", SocialDemoID: "social_demo", Date: time.Now()}
 
-		_, err := db.Query("INSERT INTO banners_rotation (slot_id, banner_id) VALUES ($1, $2)", slotID, bannerID)
-		require.NoError(t, err, "should be without errors")
+	t.Run("test publish", func(t *testing.T) {
+		dialer := &testDialer{}
+		producer := simpleproducer.New("banners-rotation-test-"+uuid.NewString(), dialer.dial, backoff, 5*time.Second, testLogger{})
+		defer producer.Close()
 
-		_, err = db.Exec("INSERT INTO views (slot_id,banner_id,social_demo_id,date) VALUES ($1,$2,$3,$4)", slotID, bannerID, "", "")
-		require.NoError(t, err, "should be without errors")
+		require.NoError(t, producer.Connect(), "should be without errors")
+		require.Equal(t, simpleproducer.StateConnected, producer.State())
+		require.NoError(t, producer.Publish(message), "message should be confirmed")
+	})
 
-		notViewedBanners, err := storage.GetNotViewedBanners(slotID)
-		require.NoError(t, err, "should be without errors")
+	t.Run("test reconnection", func(t *testing.T) {
+		dialer := &testDialer{}
+		producer := simpleproducer.New("banners-rotation-test-"+uuid.NewString(), dialer.dial, backoff, 5*time.Second, testLogger{})
+		defer producer.Close()
 
-		require.Len(t, notViewedBanners, 0, "should be empty array")
-	})
+		require.NoError(t, producer.Connect(), "should be without errors")
 
-	t.Run("test get banners in slot", func(t *testing.T) {
-		bannerID := uuid.NewString()
-		slotID := uuid.NewString()
+		conn, _ := dialer.last()
+		require.NoError(t, conn.Close())
 
-		_, err := db.Query("INSERT INTO banners_rotation (slot_id, banner_id) VALUES ($1, $2)", slotID, bannerID)
-		require.NoError(t, err, "should be without errors")
+		require.Eventually(t, func() bool {
+			_, dials := dialer.last()
 
-		bannersInSlot, err := storage.GetBannersInSlot(slotID)
-		require.NoError(t, err, "should be without errors")
+			return dials > 1 && producer.State() == simpleproducer.StateConnected
+		}, 10*time.Second, 50*time.Millisecond, "producer should reconnect after connection is closed")
 
-		require.Len(t, bannersInSlot, 1)
-		require.Equal(t, bannerID, bannersInSlot[0].BannerID, "bannerID should be same")
-		require.Equal(t, slotID, bannersInSlot[0].SlotID, "slotID should be same")
+		require.NoError(t, producer.Publish(message), "message should be published by new connection")
+
+		require.NoError(t, producer.Close())
+		require.Equal(t, simpleproducer.StateClosed, producer.State())
 	})
 }
 
@@ -313,6 +335,9 @@ func TestHTTP(t *testing.T) {
 	httpRemoveBanner := HTTPHost + "/api/v1/banners/remove"
 	httpAddBannerClick := HTTPHost + "/api/v1/banners/click"
 	httpGetBanner := HTTPHost + "/api/v1/banners/get"
+	httpBanners := HTTPHost + "/api/v1/admin/banners"
+	httpSlots := HTTPHost + "/api/v1/admin/slots"
+	httpStatsExport := HTTPHost + "/api/v1/admin/stats/export"
 
 	t.Run("test banner create", func(t *testing.T) {
 		id := uuid.NewString()
@@ -375,6 +400,9 @@ func TestHTTP(t *testing.T) {
 		bannerID := uuid.NewString()
 		slotID := uuid.NewString()
 
+		createItem(t, httpCreateBanner, bannerID)
+		createItem(t, httpCreateSlot, slotID)
+
 		jsonData, err := json.Marshal(AddBannerBody{BannerID: bannerID, SlotID: slotID})
 		require.NoError(t, err, "should be without errors")
 
@@ -392,7 +420,14 @@ func TestHTTP(t *testing.T) {
 	})
 
 	t.Run("test remove banner from rotation", func(t *testing.T) {
-		jsonData, err := json.Marshal(RemoveBannerBody{BannerID: "banner1", SlotID: "slot1"})
+		bannerID := uuid.NewString()
+		slotID := uuid.NewString()
+
+		createItem(t, httpCreateBanner, bannerID)
+		createItem(t, httpCreateSlot, slotID)
+		addBannerToRotation(t, httpAddBanner, bannerID, slotID)
+
+		jsonData, err := json.Marshal(RemoveBannerBody{BannerID: bannerID, SlotID: slotID})
 		require.NoError(t, err, "should be without errors")
 
 		resp, err := http.Post(httpRemoveBanner, "application/json",
@@ -413,6 +448,10 @@ func TestHTTP(t *testing.T) {
 		slotID := uuid.NewString()
 		socialDemoID := uuid.NewString()
 
+		createItem(t, httpCreateBanner, bannerID)
+		createItem(t, httpCreateSlot, slotID)
+		createItem(t, httpCreateSocialDemo, socialDemoID)
+
 		jsonData, err := json.Marshal(AddBannerClickBody{BannerID: bannerID, SlotID: slotID, SocialDemoID: socialDemoID})
 		require.NoError(t, err, "should be without errors")
 
@@ -430,9 +469,17 @@ func TestHTTP(t *testing.T) {
 	})
 
 	t.Run("test get banner", func(t *testing.T) {
+		slotID := uuid.NewString()
 		socialDemoID := uuid.NewString()
 
-		jsonData, err := json.Marshal(GetBannerBody{SlotID: "slot1", SocialDemoID: socialDemoID})
+		bannerID := uuid.NewString()
+
+		createItem(t, httpCreateBanner, bannerID)
+		createItem(t, httpCreateSlot, slotID)
+		createItem(t, httpCreateSocialDemo, socialDemoID)
+		addBannerToRotation(t, httpAddBanner, bannerID, slotID)
+
+		jsonData, err := json.Marshal(GetBannerBody{SlotID: slotID, SocialDemoID: socialDemoID})
 		require.NoError(t, err, "should be without errors")
 
 		resp, err := http.Post(httpGetBanner, "application/json",
@@ -476,6 +523,210 @@ func TestHTTP(t *testing.T) {
 		require.Equal(t, http.StatusNotFound, resp.StatusCode, "response statuscode should be bad request")
 	})
 
+	t.Run("test add not existed banner to rotation", func(t *testing.T) {
+		slotID := uuid.NewString()
+
+		createItem(t, httpCreateSlot, slotID)
+
+		jsonData, err := json.Marshal(AddBannerBody{BannerID: uuid.NewString(), SlotID: slotID})
+		require.NoError(t, err, "should be without errors")
+
+		resp, err := http.Post(httpAddBanner, "application/json",
+			bytes.NewBuffer(jsonData))
+
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, http.StatusNotFound, resp.StatusCode, "response statuscode should be not found")
+	})
+
+	t.Run("test add banner to rotation twice", func(t *testing.T) {
+		bannerID := uuid.NewString()
+		slotID := uuid.NewString()
+
+		createItem(t, httpCreateBanner, bannerID)
+		createItem(t, httpCreateSlot, slotID)
+		addBannerToRotation(t, httpAddBanner, bannerID, slotID)
+
+		jsonData, err := json.Marshal(AddBannerBody{BannerID: bannerID, SlotID: slotID})
+		require.NoError(t, err, "should be without errors")
+
+		resp, err := http.Post(httpAddBanner, "application/json",
+			bytes.NewBuffer(jsonData))
+
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, http.StatusConflict, resp.StatusCode, "response statuscode should be conflict")
+	})
+
+	t.Run("test create existed banner", func(t *testing.T) {
+		id := uuid.NewString()
+
+		createItem(t, httpCreateBanner, id)
+
+		jsonData, err := json.Marshal(CreateBody{ID: id, Description: ""})
+		require.NoError(t, err, "should be without errors")
+
+		resp, err := http.Post(httpCreateBanner, "application/json",
+			bytes.NewBuffer(jsonData))
+
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, http.StatusConflict, resp.StatusCode, "response statuscode should be conflict")
+	})
+
+	t.Run("test banner update, read and delete", func(t *testing.T) {
+		bannerID := uuid.NewString()
+		slotID := uuid.NewString()
+
+		createItem(t, httpCreateBanner, bannerID)
+		createItem(t, httpCreateSlot, slotID)
+		addBannerToRotation(t, httpAddBanner, bannerID, slotID)
+
+		jsonData, err := json.Marshal(CreateBody{ID: bannerID, Description: "updated"})
+		require.NoError(t, err, "should be without errors")
+
+		resp, err := http.Post(httpBanners+"/update", "application/json", bytes.NewBuffer(jsonData))
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, http.StatusOK, resp.StatusCode, "response statuscode should be ok")
+
+		resp, err = http.Get(httpBanners + "/" + bannerID)
+		require.NoError(t, err, "should be without errors")
+
+		var banner CreateBody
+
+		err = json.NewDecoder(resp.Body).Decode(&banner)
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, CreateBody{ID: bannerID, Description: "updated"}, banner)
+
+		jsonData, err = json.Marshal(IDResponse{ID: bannerID})
+		require.NoError(t, err, "should be without errors")
+
+		resp, err = http.Post(httpBanners+"/delete", "application/json", bytes.NewBuffer(jsonData))
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, http.StatusOK, resp.StatusCode, "response statuscode should be ok")
+
+		resp, err = http.Get(httpBanners + "/" + bannerID)
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, http.StatusNotFound, resp.StatusCode, "response statuscode should be not found")
+
+		jsonData, err = json.Marshal(GetBannerBody{SlotID: slotID, SocialDemoID: uuid.NewString()})
+		require.NoError(t, err, "should be without errors")
+
+		resp, err = http.Post(httpGetBanner, "application/json", bytes.NewBuffer(jsonData))
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, http.StatusNotFound, resp.StatusCode, "rotation should be deleted with banner")
+	})
+
+	t.Run("test list slots", func(t *testing.T) {
+		createItem(t, httpCreateSlot, uuid.NewString())
+		createItem(t, httpCreateSlot, uuid.NewString())
+
+		resp, err := http.Get(httpSlots + "?page_size=1")
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, http.StatusOK, resp.StatusCode, "response statuscode should be ok")
+
+		var response ListSlotsResponse
+
+		err = json.NewDecoder(resp.Body).Decode(&response)
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, response.Slots, 1)
+		require.NotEmpty(t, response.NextPageToken, "next page should exist")
+
+		resp, err = http.Get(httpSlots + "?page_size=1&page_token=" + response.NextPageToken)
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, http.StatusOK, resp.StatusCode, "response statuscode should be ok")
+
+		var nextResponse ListSlotsResponse
+
+		err = json.NewDecoder(resp.Body).Decode(&nextResponse)
+		require.NoError(t, err, "should be without errors")
+		require.Len(t, nextResponse.Slots, 1)
+		require.Greater(t, nextResponse.Slots[0].ID, response.Slots[0].ID, "slots should be ordered by id")
+	})
+
+	t.Run("test stats export", func(t *testing.T) {
+		bannerID := uuid.NewString()
+		slotID := uuid.NewString()
+		socialDemoID := uuid.NewString()
+
+		createItem(t, httpCreateBanner, bannerID)
+		createItem(t, httpCreateSlot, slotID)
+		createItem(t, httpCreateSocialDemo, socialDemoID)
+		addBannerToRotation(t, httpAddBanner, bannerID, slotID)
+
+		jsonData, err := json.Marshal(AddBannerClickBody{BannerID: bannerID, SlotID: slotID, SocialDemoID: socialDemoID})
+		require.NoError(t, err, "should be without errors")
+
+		resp, err := http.Post(httpAddBannerClick, "application/json", bytes.NewBuffer(jsonData))
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, http.StatusOK, resp.StatusCode, "response statuscode should be ok")
+
+		resp, err = http.Get(httpStatsExport + "?format=csv&group_by=slot,banner&slot_id=" + slotID)
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, http.StatusOK, resp.StatusCode, "response statuscode should be ok")
+
+		records, err := csv.NewReader(resp.Body).ReadAll()
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, [][]string{
+			{"slot_id", "banner_id", "social_demo_id", "time", "views", "clicks", "ctr"},
+			{slotID, bannerID, "", "", "0", "1", "0"},
+		}, records)
+
+		resp, err = http.Get(httpStatsExport + "?format=jsonl&mode=raw&slot_id=" + slotID)
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, http.StatusOK, resp.StatusCode, "response statuscode should be ok")
+
+		var event map[string]string
+
+		err = json.NewDecoder(resp.Body).Decode(&event)
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, "click", event["type"])
+		require.Equal(t, socialDemoID, event["social_demo_id"])
+
+		resp, err = http.Get(httpStatsExport + "?format=xml")
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode, "response statuscode should be bad request")
+	})
+
+	t.Run("test click redirect", func(t *testing.T) {
+		bannerID := uuid.NewString()
+		slotID := uuid.NewString()
+		socialDemoID := uuid.NewString()
+
+		jsonData, err := json.Marshal(BannerBody{ID: bannerID, TargetURL: "https://example.com/landing"})
+		require.NoError(t, err, "should be without errors")
+
+		resp, err := http.Post(httpCreateBanner, "application/json", bytes.NewBuffer(jsonData))
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, http.StatusOK, resp.StatusCode, "response statuscode should be ok")
+
+		createItem(t, httpCreateSlot, slotID)
+		createItem(t, httpCreateSocialDemo, socialDemoID)
+		addBannerToRotation(t, httpAddBanner, bannerID, slotID)
+
+		jsonData, err = json.Marshal(GetBannerBody{SlotID: slotID, SocialDemoID: socialDemoID})
+		require.NoError(t, err, "should be without errors")
+
+		resp, err = http.Post(httpGetBanner, "application/json", bytes.NewBuffer(jsonData))
+		require.NoError(t, err, "should be without errors")
+
+		var served ServedBannerResponse
+
+		err = json.NewDecoder(resp.Body).Decode(&served)
+		require.NoError(t, err, "should be without errors")
+		require.NotEmpty(t, served.ImpressionToken, "impression token should be given")
+
+		client := &http.Client{CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		}}
+
+		resp, err = client.Get(HTTPHost + "/c/" + served.ImpressionToken)
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, http.StatusFound, resp.StatusCode, "response statuscode should be found")
+		require.Equal(t, "https://example.com/landing", resp.Header.Get("Location"))
+
+		resp, err = client.Get(HTTPHost + "/c/invalid")
+		require.NoError(t, err, "should be without errors")
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode, "response statuscode should be bad request")
+	})
+
 	t.Run("test empty body add banner", func(t *testing.T) {
 		resp, err := http.Post(httpAddBanner, "application/json",
 			nil)
@@ -508,3 +759,39 @@ func TestHTTP(t *testing.T) {
 		require.Equal(t, http.StatusBadRequest, resp.StatusCode, "response statuscode should be bad request")
 	})
 }
+
+func addBannerToRotation(t *testing.T, url, bannerID, slotID string) {
+	t.Helper()
+
+	jsonData, err := json.Marshal(AddBannerBody{BannerID: bannerID, SlotID: slotID})
+	require.NoError(t, err, "should be without errors")
+
+	resp, err := http.Post(url, "application/json", bytes.NewBuffer(jsonData))
+	require.NoError(t, err, "should be without errors")
+	require.Equal(t, http.StatusOK, resp.StatusCode, "response statuscode should be ok")
+}
+
+func createItem(t *testing.T, url, id string) {
+	t.Helper()
+
+	jsonData, err := json.Marshal(CreateBody{ID: id, Description: ""})
+	require.NoError(t, err, "should be without errors")
+
+	resp, err := http.Post(url, "application/json", bytes.NewBuffer(jsonData))
+	require.NoError(t, err, "should be without errors")
+	require.Equal(t, http.StatusOK, resp.StatusCode, "response statuscode should be ok")
+}
+
+// createItems inserts items referenced by rotations and events, empty id is skipped.
+func createItems(t *testing.T, db *sqlx.DB, slotID, bannerID, socialDemoID string) {
+	t.Helper()
+
+	for table, id := range map[string]string{"slots": slotID, "banners": bannerID, "social_demos": socialDemoID} {
+		if id == "" {
+			continue
+		}
+
+		_, err := db.Exec("INSERT INTO "+table+" (id) VALUES ($1) ON CONFLICT DO NOTHING", id)
+		require.NoError(t, err, "should be without errors")
+	}
+}